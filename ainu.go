@@ -0,0 +1,174 @@
+package kanaconv
+
+import (
+	"errors"
+	"strings"
+)
+
+//	ainuFinals are the kana which stand for a syllable-final consonant in the Ainu orthography,
+//	mostly the small kana of the Katakana Phonetic Extensions (ㇰ -> k).
+var ainuFinals = map[rune]string{
+	'ㇰ': "k",
+	'ㇱ': "s",
+	'ㇲ': "s",
+	'ㇳ': "t",
+	'ㇴ': "n",
+	'ㇵ': "h",
+	'ㇶ': "h",
+	'ㇷ': "h",
+	'ㇸ': "h",
+	'ㇹ': "h",
+	'ㇺ': "m",
+	'ㇻ': "r",
+	'ㇼ': "r",
+	'ㇽ': "r",
+	'ㇾ': "r",
+	'ㇿ': "r",
+	'ッ': "t",
+	'ン': "n",
+}
+
+//	ainuSemiVoiced are the kana which are written with handakuten only in the Ainu orthography (ト゚ -> tu).
+var ainuSemiVoiced = map[rune]string{
+	'ㇷ': "p",
+	'ト': "tu",
+	'ツ': "tu",
+	'セ': "ce",
+}
+
+//	ainuSyllables are the kana which are spelled differently from Hepburn (チ -> ci).
+var ainuSyllables = map[string]string{
+	"シ":  "si",
+	"チ":  "ci",
+	"ツ":  "tu",
+	"フ":  "hu",
+	"ヰ":  "wi",
+	"ヱ":  "we",
+	"ヲ":  "wo",
+	"シャ": "sa",
+	"シュ": "su",
+	"シェ": "se",
+	"ショ": "so",
+	"チャ": "ca",
+	"チュ": "cu",
+	"チェ": "ce",
+	"チョ": "co",
+	"トゥ": "tu",
+	"イェ": "ye",
+	"ウィ": "wi",
+	"ウェ": "we",
+	"ウォ": "wo",
+}
+
+//	ainuToRomaji transliterates Ainu kana to romaji (アイヌㇷ゚ -> aynup).
+//	Small kana, ッ and ン become syllable-final consonants, イ and ウ after a vowel become "y" and "w".
+//	Kana without an Ainu spelling are converted with Hepburn, iteration marks repeat the preceding kana (カヽ -> kaka).
+func ainuToRomaji(str string, o *options) (string, error) {
+	var sb strings.Builder
+	sb.Grow(len(str))
+
+	// rPrev is the last written syllable
+	var rPrev string
+	// rBase is the kana which an iteration mark repeats
+	var rBase rune
+	// spans are the romaji of katakana, see CasingWapuro
+	var spans katakanaSpans
+	var convErrs ConversionErrors
+	runes := decodeKana(str)
+	for i := 0; i < len(runes); i++ {
+		kana := runes[i]
+		r := toKatakana(kana.r)
+		if isIterationMark(r) && rBase != 0 {
+			r = iterate(rBase, r)
+		}
+		rBase = iterationBase(rBase, r)
+
+		next := toKatakana(nextRune(runes, i))
+		if punctuation, ok := o.punctuation[r]; ok {
+			o.punctuation.write(&sb, punctuation, next)
+			rPrev = ""
+			continue
+		}
+
+		afterVowel := len(rPrev) != 0 && isVowel(rPrev[len(rPrev)-1])
+
+		var rStr string
+		if semiVoiced, ok := ainuSemiVoiced[r]; ok && (next == combiningHandakuten || next == spacingHandakuten) {
+			rStr = semiVoiced
+			i++
+		} else if syllable, ok := ainuSyllables[string([]rune{r, next})]; ok {
+			rStr = syllable
+			i++
+		} else if afterVowel && (r == 'イ' || r == 'ィ') {
+			rStr = "y"
+		} else if afterVowel && (r == 'ウ' || r == 'ゥ') {
+			rStr = "w"
+		} else if afterVowel && r == 'ー' {
+			rStr = rPrev[len(rPrev)-1:]
+		} else if final, ok := ainuFinals[r]; ok {
+			rStr = final
+		} else if syllable, ok := ainuSyllables[string(r)]; ok {
+			rStr = syllable
+		} else {
+			syllable := []rune{r}
+			if isYouon(next) {
+				syllable = append(syllable, next)
+			}
+
+			hepburn, err := KanaToRomaji(string(syllable))
+			if err == nil {
+				rStr = hepburn
+				i += len(syllable) - 1
+			} else if !IsKana(r) && o.isPassthrough {
+				sb.WriteString(kana.source)
+				rPrev = ""
+				continue
+			} else {
+				var hepburnErr *ConversionError
+				errors.As(err, &hepburnErr)
+
+				sentinel := hepburnErr.Err
+				if r == 'ー' && len(rPrev) != 0 {
+					// Hepburn sees only the chōonpu
+					sentinel = ErrChouonpuConsonant
+				}
+
+				convErr := newConversionError(sentinel, kana)
+				if !o.isLenient {
+					return "", convErr
+				}
+
+				convErrs = append(convErrs, convErr)
+				o.replacement.write(&sb, kana.source)
+				rPrev = ""
+				continue
+			}
+		}
+
+		start := sb.Len()
+		sb.WriteString(rStr)
+		spans.add(&sb, start, IsKatakana(kana.r))
+		rPrev = rStr
+	}
+
+	result := sb.String()
+	if o.casing == CasingWapuro {
+		result = spans.upper(result)
+	}
+
+	if len(convErrs) != 0 {
+		return result, convErrs
+	}
+
+	return result, nil
+}
+
+//	isYouon checks whether the small kana is combined with the preceding kana (キャ, ファ)
+func isYouon(r rune) bool {
+	switch r {
+	case 'ャ', 'ュ', 'ョ', 'ァ', 'ィ', 'ゥ', 'ェ', 'ォ', 'ヮ':
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,73 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAinu(t *testing.T) {
+	input := []inp{
+		{input: "アイヌㇷ゚", want: "aynup"},
+		{input: "アイヌ", want: "aynu"},
+		{input: "イランカラㇷ゚テ", want: "irankarapte"},
+		{input: "ピㇼカ", want: "pirka"},
+		{input: "イタㇰ", want: "itak"},
+		{input: "カムイ", want: "kamuy"},
+		{input: "ト゚ム", want: "tumu"},
+		{input: "セ゚", want: "ce"},
+		{input: "チセ", want: "cise"},
+		{input: "シサㇺ", want: "sisam"},
+		{input: "チャㇱ", want: "cas"},
+		{input: "ホㇱ", want: "hos"},
+		{input: "アッ", want: "at"},
+		{input: "コタン", want: "kotan"},
+		{input: "ヲ", want: "wo"},
+		{input: "イェ", want: "ye"},
+		{input: "あいぬ", want: "aynu"},
+		{input: "キャ", want: "kya"},
+		{input: "エー", want: "ee"},
+		{input: "カヽ", want: "kaka"},
+		{input: "カヾ", want: "kaga"},
+		{input: "ピㇼカゝ", want: "pirkaka"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithAinu())
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestAinuPassthrough(t *testing.T) {
+	got, err := KanaToRomaji("アイヌ語 (アイヌ イタㇰ)", WithAinu(), WithPassthrough())
+	assert.Equal(t, "aynu語 (aynu itak)", got)
+	assert.Nil(t, err)
+}
+
+func TestAinuErrors(t *testing.T) {
+	input := []struct {
+		input string
+		err   error
+		index int
+	}{
+		{input: "アイヌ語", err: ErrNotKana, index: 3},
+		{input: "ャ", err: ErrYouonFirst, index: 0},
+		{input: "ー", err: ErrChouonpuFirst, index: 0},
+		{input: "ㇰー", err: ErrChouonpuConsonant, index: 1},
+		{input: "ヽカ", err: ErrIterationMarkFirst, index: 0},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithAinu())
+		assert.Empty(t, got)
+		assert.ErrorIs(t, err, v.err)
+		assert.Equal(t, v.index, err.(*ConversionError).Index)
+	}
+}
+
+func TestAinuSmallKanaRejected(t *testing.T) {
+	got, err := KanaToRomaji("アイヌㇷ゚")
+	assert.Empty(t, got)
+	assert.ErrorIs(t, err, ErrNotKana)
+}
@@ -0,0 +1,88 @@
+package kanaconv
+
+import (
+	"strings"
+	"unicode"
+)
+
+//	Casing is the capitalization of the romaji written by KanaToRomaji.
+type Casing int8
+
+const (
+	//	CasingLower writes the romaji in lowercase (kore wa pen desu). It is the default.
+	CasingLower Casing = iota
+	//	CasingSentence capitalizes the first letter of every sentence (Kore wa pen desu. Sou desu).
+	CasingSentence
+	//	CasingTitle capitalizes the first letter of every word (Kore Wa Pen Desu).
+	CasingTitle
+	//	CasingUpper writes the romaji in uppercase (KORE WA PEN DESU), e.g. for passports and signage.
+	CasingUpper
+	//	CasingWapuro writes the romaji of katakana in uppercase and the romaji of hiragana in lowercase (kore ha KONPYUUTA desu),
+	//	the sokuon is written in the script of its own kana (まっカ -> makKA).
+	CasingWapuro
+)
+
+//	apply changes the capitalization of the lowercase romaji, CasingWapuro is applied during the conversion.
+//	CasingTitle does not capitalize the letter after the separator of ん (kin'en -> Kin'en).
+func (c Casing) apply(str, nSeparator string) string {
+	switch c {
+	case CasingUpper:
+		return strings.ToUpper(str)
+	case CasingSentence, CasingTitle:
+		var sb strings.Builder
+		sb.Grow(len(str))
+
+		// capitalizes is set when the next letter starts a sentence or a word
+		capitalizes := true
+		for _, r := range str {
+			switch {
+			case unicode.IsLetter(r):
+				if capitalizes {
+					r = unicode.ToUpper(r)
+					capitalizes = false
+				}
+			case c == CasingSentence:
+				capitalizes = capitalizes || r == '.' || r == '!' || r == '?'
+			default:
+				capitalizes = !unicode.IsDigit(r) && r != '\'' && !strings.ContainsRune(nSeparator, r)
+			}
+
+			sb.WriteRune(r)
+		}
+
+		return sb.String()
+	default:
+		return str
+	}
+}
+
+//	katakanaSpans are the byte ranges of the romaji which was written for katakana, they are uppercased with CasingWapuro
+type katakanaSpans []int
+
+//	add records the romaji written since start if it was written for katakana
+func (s *katakanaSpans) add(sb *strings.Builder, start int, isKatakana bool) {
+	if isKatakana && sb.Len() > start {
+		*s = append(*s, start, sb.Len())
+	}
+}
+
+//	upper writes the spans of the romaji in uppercase
+func (s katakanaSpans) upper(str string) string {
+	if len(s) == 0 {
+		return str
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(str))
+
+	offset := 0
+	for i := 0; i < len(s); i += 2 {
+		sb.WriteString(str[offset:s[i]])
+		sb.WriteString(strings.ToUpper(str[s[i]:s[i+1]]))
+		offset = s[i+1]
+	}
+
+	sb.WriteString(str[offset:])
+
+	return sb.String()
+}
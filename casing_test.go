@@ -0,0 +1,132 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCasing(t *testing.T) {
+	input := []struct {
+		input  string
+		casing Casing
+		want   string
+	}{
+		{input: "これは ぺん です", casing: CasingLower, want: "koreha pen desu"},
+		{input: "これは ぺん です。そう です", casing: CasingSentence, want: "Koreha pen desu. Sou desu"},
+		{input: "なに？ ほんとう", casing: CasingSentence, want: "Nani? Hontou"},
+		{input: "これは ぺん です", casing: CasingTitle, want: "Koreha Pen Desu"},
+		{input: "しんいち", casing: CasingTitle, want: "Shin'ichi"},
+		{input: "ラーメン・ライス", casing: CasingTitle, want: "Raamen-Raisu"},
+		{input: "これは ぺん です", casing: CasingUpper, want: "KOREHA PEN DESU"},
+		{input: "これは コンピュータ です", casing: CasingWapuro, want: "koreha KONPYUUTA desu"},
+		{input: "コーヒーを のむ", casing: CasingWapuro, want: "KOOHIIwo nomu"},
+		{input: "ー", casing: CasingWapuro, want: ""},
+	}
+
+	punctuation := PunctuationASCII()
+	punctuation['・'] = "-"
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithPassthrough(), WithPunctuation(punctuation), WithNSeparator("'"), WithCasing(v.casing))
+		assert.Equal(t, v.want, got)
+
+		if v.input != "ー" {
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func TestCasingWapuroScripts(t *testing.T) {
+	input := []inp{
+		{input: "テレビをみる", want: "TEREBIwomiru"},
+		{input: "すごーい", want: "sugooi"},
+		{input: "バヽ", want: "BABA"},
+		{input: "㌔", want: "KIRO"},
+		{input: "ｶﾀｶﾅ", want: "KATAKANA"},
+		{input: "いすゞ", want: "isuzu"},
+		{input: "テレビゃ", want: "TEREBYA"},
+		{input: "まっカ", want: "makKA"},
+		{input: "まッか", want: "maKka"},
+		{input: "カッ", want: "KA"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithCasing(CasingWapuro))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestCasingWapuroLatin(t *testing.T) {
+	input := []inp{
+		{input: "カメラiPhone", want: "KAMERAiPhone"},
+		{input: "コンピュータabc です", want: "KONPYUUTAabc desu"},
+		{input: "iPhoneのカメラ", want: "iPhonenoKAMERA"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithCasing(CasingWapuro), WithPassthrough())
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestCasingWapuroContext(t *testing.T) {
+	input := []struct {
+		input string
+		opts  []Option
+		want  string
+	}{
+		{input: "はい。コーヒー", opts: []Option{WithPunctuation(PunctuationASCII())}, want: "hai. KOOHII"},
+		{input: "さんポ", opts: []Option{WithPassport(false)}, want: "samPO"},
+		{input: "きんエン", opts: []Option{WithNSeparator("'")}, want: "kin'EN"},
+		{input: "一ヶ月", opts: []Option{WithPassthrough(), WithCounterKana()}, want: "一KA月"},
+		{input: "アッ", opts: []Option{WithDanglingSokuon(DanglingSokuonXtsu)}, want: "AXTSU"},
+		{input: "アイヌ", opts: []Option{WithAinu()}, want: "AYNU"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, append(v.opts, WithCasing(CasingWapuro))...)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestCasingTitleNSeparator(t *testing.T) {
+	got, err := KanaToRomaji("きんえん", WithNSeparator("-"), WithCasing(CasingTitle))
+	assert.Equal(t, "Kin-en", got)
+	assert.Nil(t, err)
+}
+
+func TestCasingWapuroErrors(t *testing.T) {
+	_, err := KanaToRomaji("あいンャ", WithCasing(CasingWapuro))
+	assert.ErrorIs(t, err, ErrYouonCombination)
+	assert.Equal(t, 3, err.(*ConversionError).Index)
+
+	got, err := KanaToRomaji("あゃテレビ", WithCasing(CasingWapuro), WithLenient(ReplaceWithQuestionMark))
+	assert.Equal(t, "a?TEREBI", got)
+	assert.ErrorIs(t, err, ErrYouonCombination)
+}
+
+func TestCasingOptions(t *testing.T) {
+	got, err := KanaToRomaji("とうきょう", WithPassport(false))
+	assert.Equal(t, "TOKYO", got)
+	assert.Nil(t, err)
+
+	got, err = KanaToRomaji("とうきょう", WithPassport(false), WithCasing(CasingTitle))
+	assert.Equal(t, "Tokyo", got)
+	assert.Nil(t, err)
+
+	got, err = KanaToRomaji("わたし は コーヒー を のむ", WithParticles(nil), WithCasing(CasingWapuro))
+	assert.Equal(t, "watashi wa KOOHII o nomu", got)
+	assert.Nil(t, err)
+
+	got, err = KanaToRomaji("わたし は がくせい", WithParticles(nil), WithCasing(CasingSentence))
+	assert.Equal(t, "Watashi wa gakusei", got)
+	assert.Nil(t, err)
+
+	got, err = KanaToRomaji("アイヌ イタㇰ", WithAinu(), WithPassthrough(), WithCasing(CasingTitle))
+	assert.Equal(t, "Aynu Itak", got)
+	assert.Nil(t, err)
+}
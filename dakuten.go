@@ -0,0 +1,108 @@
+package kanaconv
+
+const (
+	// combining sound marks which follow the kana in decomposed (NFD) text, e.g. わ゙
+	combiningDakuten    = '\u3099'
+	combiningHandakuten = '\u309a'
+	// spacing sound marks (゛, ゜) which are written after the kana instead of the combining ones
+	spacingDakuten    = '゛'
+	spacingHandakuten = '゜'
+)
+
+//	withDakuten returns the voiced twin of the kana (か -> が, ウ -> ヴ).
+//	わ, ゐ, ゑ and を have no voiced hiragana, therefore ヷ, ヸ, ヹ and ヺ are returned for them.
+func withDakuten(r rune) (rune, bool) {
+	switch r {
+	case 'う':
+		return 'ゔ', true
+	case 'ウ':
+		return 'ヴ', true
+	case 'ゝ':
+		return 'ゞ', true
+	case 'ヽ':
+		return 'ヾ', true
+	}
+
+	if v, ok := voicedW(r); ok {
+		return v, true
+	}
+
+	hiragana := toHiragana(r)
+
+	var voiced rune
+	switch {
+	case hiragana >= 'か' && hiragana <= 'ぢ' && (hiragana-'か')%2 == 0,
+		hiragana >= 'つ' && hiragana <= 'ど' && (hiragana-'つ')%2 == 0,
+		hiragana >= 'は' && hiragana <= 'ほ' && (hiragana-'は')%3 == 0:
+		voiced = hiragana + 1
+	default:
+		return 0, false
+	}
+
+	if hiragana != r {
+		voiced += katakanaOffset
+	}
+
+	return voiced, true
+}
+
+//	withHandakuten returns the semi-voiced twin of the kana (は -> ぱ).
+func withHandakuten(r rune) (rune, bool) {
+	hiragana := toHiragana(r)
+	if hiragana < 'は' || hiragana > 'ほ' || (hiragana-'は')%3 != 0 {
+		return 0, false
+	}
+
+	semiVoiced := hiragana + 2
+	if hiragana != r {
+		semiVoiced += katakanaOffset
+	}
+
+	return semiVoiced, true
+}
+
+//	withSoundMark combines the kana with the following dakuten or handakuten, either half-width, combining or spacing (か゛ -> が).
+func withSoundMark(r, mark rune) (rune, bool) {
+	switch mark {
+	case halfwidthDakuten, combiningDakuten, spacingDakuten:
+		return withDakuten(r)
+	case halfwidthHandakuten, combiningHandakuten, spacingHandakuten:
+		return withHandakuten(r)
+	default:
+		return 0, false
+	}
+}
+
+//	isIterationMark checks whether the rune repeats the preceding kana.
+func isIterationMark(r rune) bool {
+	switch r {
+	case 'ゝ', 'ゞ', 'ヽ', 'ヾ', '〻':
+		return true
+	default:
+		return false
+	}
+}
+
+//	iterationBase returns the kana which an iteration mark after r repeats, base is the kana repeated before r.
+//	Small kana, sokuon and chōonpu keep the base (きゃゝ -> きゃき), other characters which are not kana clear it.
+func iterationBase(base, r rune) rune {
+	switch {
+	case r == 'ー' || r == 'っ' || r == 'ッ' || isYouon(toKatakana(r)):
+		return base
+	case IsKana(r) && !isIterationMark(r):
+		return r
+	default:
+		return 0
+	}
+}
+
+//	iterate returns the kana which the iteration mark stands for, ゞ and ヾ voice the kana if it has a voiced twin (す -> ず).
+func iterate(kana, mark rune) rune {
+	if mark == 'ゞ' || mark == 'ヾ' {
+		if voiced, ok := withDakuten(kana); ok {
+			return voiced
+		}
+	}
+
+	return kana
+}
@@ -0,0 +1,72 @@
+package kanaconv
+
+import "unicode/utf8"
+
+//	kanaRune is a decoded character with its byte offset and rune index in the source string.
+//	source is the text which the character was decoded from (ｶﾞ for ガ).
+type kanaRune struct {
+	r      rune
+	offset int
+	index  int
+	source string
+}
+
+//	decodeKana decodes a string to runes.
+//	Half-width katakana are converted to full-width and sound marks (half-width, combining or spacing)
+//	are combined with the preceding kana (ｶﾞ -> ガ, か゛ -> が), ligatures, enclosed and squared katakana
+//	are expanded (ゟ -> より, ㌔ -> キロ).
+func decodeKana(str string) []kanaRune {
+	runes := make([]kanaRune, 0, len(str)/3)
+
+	for i, index := 0, 0; i < len(str); index++ {
+		r, size := decodeRune(str, i)
+		kana := kanaRune{offset: i, index: index}
+
+		if isHalfwidth(r) {
+			r = toFullwidth(r)
+		}
+
+		if next, nextSize := decodeRune(str, i+size); nextSize != 0 {
+			if composed, ok := withSoundMark(r, next); ok {
+				r = composed
+				size += nextSize
+				index++
+			}
+		}
+
+		kana.source = str[i : i+size]
+		if expanded, ok := expandKana(r); ok {
+			// all kana point at the expanded character
+			for _, r := range expanded {
+				kana.r = r
+				runes = append(runes, kana)
+			}
+		} else {
+			kana.r = r
+			runes = append(runes, kana)
+		}
+
+		i += size
+	}
+
+	return runes
+}
+
+//	decodeRune decodes a rune at the byte position, kana is decoded with getKanaRune.
+//	Invalid UTF-8 is decoded as utf8.RuneError of size 1.
+func decodeRune(str string, i int) (rune, int) {
+	const byteCount = 3
+
+	if i >= len(str) {
+		return utf8.RuneError, 0
+	} else if str[i]&0b_1111_0000 == 0b_1110_0000 && i+byteCount <= len(str) && isContinuation(str[i+1]) && isContinuation(str[i+2]) {
+		return getKanaRune(str[i], str[i+1], str[i+2]), byteCount
+	}
+
+	return utf8.DecodeRuneInString(str[i:])
+}
+
+//	isContinuation checks whether the byte continues a multi-byte UTF-8 character (10xxxxxx)
+func isContinuation(b byte) bool {
+	return b&0b_1100_0000 == 0b_1000_0000
+}
@@ -0,0 +1,165 @@
+package kanaconv
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	//	ErrInvalidLength is returned when the byte length of the input shows that not all characters are kana.
+	ErrInvalidLength = errors.New("all characters must be kana (3-bit unicode characters)")
+	//	ErrNotKana is returned for a character which is not kana.
+	ErrNotKana = errors.New("there is not a valid kana character")
+	//	ErrSokuonBeforeVowel is returned when sokuon (っ) precedes a vowel.
+	ErrSokuonBeforeVowel = errors.New("sokuon cannot precede a vowel")
+	//	ErrDanglingSokuon is returned when sokuon (っ) is not followed by a consonant and DanglingSokuonError is set.
+	ErrDanglingSokuon = errors.New("sokuon must precede a consonant")
+	//	ErrIterationMarkFirst is returned when an iteration mark (ゝ, ゞ, ヽ, ヾ, 〻) has no preceding kana.
+	ErrIterationMarkFirst = errors.New("iteration mark cannot be the first character in a kana block")
+	//	ErrYouonFirst is returned when yōon (ゃ, ぁ, etc.) has no preceding kana.
+	ErrYouonFirst = errors.New("yōon cannot be the first character in a kana block")
+	//	ErrYouonCombination is returned when yōon (ゃ, ゅ, ょ) cannot be combined with the preceding kana.
+	ErrYouonCombination = errors.New("unrecognised yōon combination")
+	//	ErrYouonVowel is returned when a small vowel cannot be combined with the preceding vowel.
+	ErrYouonVowel = errors.New("unrecognised yōon vowel")
+	//	ErrYouonSyllable is returned when a small vowel cannot be combined with the preceding kana.
+	ErrYouonSyllable = errors.New("unrecognised yōon syllable")
+	//	ErrChouonpuFirst is returned when chōonpu (ー) has no preceding kana.
+	ErrChouonpuFirst = errors.New("chōonpu cannot be the first character in a block")
+	//	ErrChouonpuConsonant is returned when chōonpu (ー) follows a kana without a vowel (ん).
+	ErrChouonpuConsonant = errors.New("chōonpu cannot extend a consonant")
+	//	ErrTableUnreachable is returned when an entry of a Table can never be matched.
+	ErrTableUnreachable = errors.New("table entry can never be matched")
+	//	ErrTableConflict is returned when two entries of a Table have the same kana but different romaji.
+	ErrTableConflict = errors.New("table entries conflict")
+	//	ErrNotRomaji is returned for characters which are not a valid romaji syllable.
+	ErrNotRomaji = errors.New("there is not a valid romaji syllable")
+)
+
+//	ErrorCode is a machine-readable code of a conversion error, every sentinel error of a conversion has one.
+type ErrorCode string
+
+const (
+	//	CodeInvalidLength is the code of ErrInvalidLength.
+	CodeInvalidLength ErrorCode = "invalid_length"
+	//	CodeNotKana is the code of ErrNotKana.
+	CodeNotKana ErrorCode = "not_kana"
+	//	CodeSokuonBeforeVowel is the code of ErrSokuonBeforeVowel.
+	CodeSokuonBeforeVowel ErrorCode = "sokuon_before_vowel"
+	//	CodeDanglingSokuon is the code of ErrDanglingSokuon.
+	CodeDanglingSokuon ErrorCode = "dangling_sokuon"
+	//	CodeIterationMarkFirst is the code of ErrIterationMarkFirst.
+	CodeIterationMarkFirst ErrorCode = "iteration_mark_first"
+	//	CodeYouonFirst is the code of ErrYouonFirst.
+	CodeYouonFirst ErrorCode = "youon_first"
+	//	CodeYouonCombination is the code of ErrYouonCombination.
+	CodeYouonCombination ErrorCode = "youon_combination"
+	//	CodeYouonVowel is the code of ErrYouonVowel.
+	CodeYouonVowel ErrorCode = "youon_vowel"
+	//	CodeYouonSyllable is the code of ErrYouonSyllable.
+	CodeYouonSyllable ErrorCode = "youon_syllable"
+	//	CodeChouonpuFirst is the code of ErrChouonpuFirst.
+	CodeChouonpuFirst ErrorCode = "chouonpu_first"
+	//	CodeChouonpuConsonant is the code of ErrChouonpuConsonant.
+	CodeChouonpuConsonant ErrorCode = "chouonpu_consonant"
+	//	CodeNotRomaji is the code of ErrNotRomaji.
+	CodeNotRomaji ErrorCode = "not_romaji"
+)
+
+var errorCodes = map[error]ErrorCode{
+	ErrInvalidLength:      CodeInvalidLength,
+	ErrNotKana:            CodeNotKana,
+	ErrSokuonBeforeVowel:  CodeSokuonBeforeVowel,
+	ErrDanglingSokuon:     CodeDanglingSokuon,
+	ErrIterationMarkFirst: CodeIterationMarkFirst,
+	ErrYouonFirst:         CodeYouonFirst,
+	ErrYouonCombination:   CodeYouonCombination,
+	ErrYouonVowel:         CodeYouonVowel,
+	ErrYouonSyllable:      CodeYouonSyllable,
+	ErrChouonpuFirst:      CodeChouonpuFirst,
+	ErrChouonpuConsonant:  CodeChouonpuConsonant,
+	ErrNotRomaji:          CodeNotRomaji,
+}
+
+//	ConversionError describes where and why a conversion failed.
+//	It wraps one of the sentinel errors (e.g. ErrSokuonBeforeVowel), which can be checked with errors.Is.
+type ConversionError struct {
+	//	Code is the machine-readable code of the error
+	Code ErrorCode
+	//	Offset is the byte offset of the offending character in the input
+	Offset int
+	//	Index is the rune index of the offending character in the input
+	Index int
+	//	Rune is the offending character
+	Rune rune
+	//	Err is the sentinel error
+	Err error
+}
+
+//	Error returns the message of the sentinel error.
+func (e *ConversionError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+func newConversionError(err error, kana kanaRune) *ConversionError {
+	return &ConversionError{
+		Code:   errorCodes[err],
+		Offset: kana.offset,
+		Index:  kana.index,
+		Rune:   kana.r,
+		Err:    err,
+	}
+}
+
+//	shift moves the position of the error by the position of the word in the whole text
+func (e *ConversionError) shift(offset, index int) {
+	e.Offset += offset
+	e.Index += index
+}
+
+//	shiftError moves the position of the error of a part of the text which starts at the offset
+func shiftError(err error, str string, offset int) {
+	index := utf8.RuneCountInString(str[:offset])
+
+	switch err := err.(type) {
+	case *ConversionError:
+		err.shift(offset, index)
+	case ConversionErrors:
+		for _, convErr := range err {
+			convErr.shift(offset, index)
+		}
+	}
+}
+
+//	ConversionErrors are all errors found by a lenient conversion (see WithLenient).
+type ConversionErrors []*ConversionError
+
+func (e ConversionErrors) Error() string {
+	var sb strings.Builder
+	for i, err := range e {
+		if i != 0 {
+			sb.WriteString("; ")
+		}
+
+		fmt.Fprintf(&sb, "%s (at %d)", err.Error(), err.Index)
+	}
+
+	return sb.String()
+}
+
+//	Is checks whether any of the errors is the target, e.g. errors.Is(err, ErrNotKana).
+func (e ConversionErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
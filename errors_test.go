@@ -0,0 +1,68 @@
+package kanaconv
+
+import (
+	"errors"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversionError(t *testing.T) {
+	input := []struct {
+		input  string
+		err    error
+		code   ErrorCode
+		offset int
+		index  int
+		r      rune
+	}{
+		{input: "かっあ", err: ErrSokuonBeforeVowel, code: CodeSokuonBeforeVowel, offset: 3, index: 1, r: 'っ'},
+		{input: "ゃき", err: ErrYouonFirst, code: CodeYouonFirst, offset: 0, index: 0, r: 'ゃ'},
+		{input: "かあぃ", err: ErrYouonVowel, code: CodeYouonVowel, offset: 6, index: 2, r: 'ぃ'},
+		{input: "かんー", err: ErrChouonpuConsonant, code: CodeChouonpuConsonant, offset: 6, index: 2, r: 'ー'},
+		{input: "ーか", err: ErrChouonpuFirst, code: CodeChouonpuFirst, offset: 0, index: 0, r: 'ー'},
+		{input: "かな日本", err: ErrNotKana, code: CodeNotKana, offset: 6, index: 2, r: '日'},
+		{input: "ｶﾞｶﾞ日", err: ErrNotKana, code: CodeNotKana, offset: 12, index: 4, r: '日'},
+		{input: "かなa", err: ErrInvalidLength, code: CodeInvalidLength, offset: 6, index: 2, r: 'a'},
+		{input: "あ\xe3AA", err: ErrNotKana, code: CodeNotKana, offset: 3, index: 1, r: utf8.RuneError},
+		{input: "ab漢字", err: ErrInvalidLength, code: CodeInvalidLength, offset: 0, index: 0, r: 'a'},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input)
+		assert.Empty(t, got)
+		assert.True(t, errors.Is(err, v.err))
+
+		var convErr *ConversionError
+		if assert.ErrorAs(t, err, &convErr) {
+			assert.Equal(t, v.code, convErr.Code)
+			assert.Equal(t, v.offset, convErr.Offset)
+			assert.Equal(t, v.index, convErr.Index)
+			assert.Equal(t, v.r, convErr.Rune)
+			assert.EqualError(t, err, v.err.Error())
+		}
+	}
+}
+
+func TestConversionErrorPassthrough(t *testing.T) {
+	_, err := KanaToRomaji("東京ゃ", WithPassthrough())
+	assert.ErrorIs(t, err, ErrYouonFirst)
+
+	var convErr *ConversionError
+	assert.ErrorAs(t, err, &convErr)
+	assert.Equal(t, 6, convErr.Offset)
+	assert.Equal(t, 2, convErr.Index)
+}
+
+func TestConversionErrorRomaji(t *testing.T) {
+	_, err := RomajiToHiragana("tōkyōq")
+	assert.ErrorIs(t, err, ErrNotRomaji)
+
+	var convErr *ConversionError
+	assert.ErrorAs(t, err, &convErr)
+	assert.Equal(t, CodeNotRomaji, convErr.Code)
+	assert.Equal(t, 7, convErr.Offset)
+	assert.Equal(t, 5, convErr.Index)
+	assert.Equal(t, 'q', convErr.Rune)
+}
@@ -0,0 +1,122 @@
+package kanaconv
+
+const (
+	firstEnclosedKatakana = '㋐'
+	lastEnclosedKatakana  = '㋾'
+	firstSquaredKatakana  = '㌀'
+	lastSquaredKatakana   = '㍗'
+)
+
+//	enclosedKatakana are the katakana written in a circle, from ㋐ to ㋾
+var enclosedKatakana = []rune("アイウエオカキクケコサシスセソタチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワヰヱヲ")
+
+//	squaredKatakana are the katakana words written in a square, from ㌀ to ㍗
+var squaredKatakana = [...]string{
+	"アパート",   // ㌀
+	"アルファ",   // ㌁
+	"アンペア",   // ㌂
+	"アール",    // ㌃
+	"イニング",   // ㌄
+	"インチ",    // ㌅
+	"ウォン",    // ㌆
+	"エスクード",  // ㌇
+	"エーカー",   // ㌈
+	"オンス",    // ㌉
+	"オーム",    // ㌊
+	"カイリ",    // ㌋
+	"カラット",   // ㌌
+	"カロリー",   // ㌍
+	"ガロン",    // ㌎
+	"ガンマ",    // ㌏
+	"ギガ",     // ㌐
+	"ギニー",    // ㌑
+	"キュリー",   // ㌒
+	"ギルダー",   // ㌓
+	"キロ",     // ㌔
+	"キログラム",  // ㌕
+	"キロメートル", // ㌖
+	"キロワット",  // ㌗
+	"グラム",    // ㌘
+	"グラムトン",  // ㌙
+	"クルゼイロ",  // ㌚
+	"クローネ",   // ㌛
+	"ケース",    // ㌜
+	"コルナ",    // ㌝
+	"コーポ",    // ㌞
+	"サイクル",   // ㌟
+	"サンチーム",  // ㌠
+	"シリング",   // ㌡
+	"センチ",    // ㌢
+	"セント",    // ㌣
+	"ダース",    // ㌤
+	"デシ",     // ㌥
+	"ドル",     // ㌦
+	"トン",     // ㌧
+	"ナノ",     // ㌨
+	"ノット",    // ㌩
+	"ハイツ",    // ㌪
+	"パーセント",  // ㌫
+	"パーツ",    // ㌬
+	"バーレル",   // ㌭
+	"ピアストル",  // ㌮
+	"ピクル",    // ㌯
+	"ピコ",     // ㌰
+	"ビル",     // ㌱
+	"ファラッド",  // ㌲
+	"フィート",   // ㌳
+	"ブッシェル",  // ㌴
+	"フラン",    // ㌵
+	"ヘクタール",  // ㌶
+	"ペソ",     // ㌷
+	"ペニヒ",    // ㌸
+	"ヘルツ",    // ㌹
+	"ペンス",    // ㌺
+	"ページ",    // ㌻
+	"ベータ",    // ㌼
+	"ポイント",   // ㌽
+	"ボルト",    // ㌾
+	"ホン",     // ㌿
+	"ポンド",    // ㍀
+	"ホール",    // ㍁
+	"ホーン",    // ㍂
+	"マイクロ",   // ㍃
+	"マイル",    // ㍄
+	"マッハ",    // ㍅
+	"マルク",    // ㍆
+	"マンション",  // ㍇
+	"ミクロン",   // ㍈
+	"ミリ",     // ㍉
+	"ミリバール",  // ㍊
+	"メガ",     // ㍋
+	"メガトン",   // ㍌
+	"メートル",   // ㍍
+	"ヤード",    // ㍎
+	"ヤール",    // ㍏
+	"ユアン",    // ㍐
+	"リットル",   // ㍑
+	"リラ",     // ㍒
+	"ルピー",    // ㍓
+	"ルーブル",   // ㍔
+	"レム",     // ㍕
+	"レントゲン",  // ㍖
+	"ワット",    // ㍗
+}
+
+//	ligatures maps the digraph kana to the kana they are written for
+var ligatures = map[rune]string{
+	'ゟ': "より",
+	'ヿ': "コト",
+}
+
+//	expandKana returns the plain kana which the ligature, enclosed or squared katakana stands for (ゟ -> より, ㋐ -> ア, ㌔ -> キロ).
+func expandKana(r rune) (string, bool) {
+	switch {
+	case r >= firstEnclosedKatakana && r <= lastEnclosedKatakana:
+		return string(enclosedKatakana[r-firstEnclosedKatakana]), true
+	case r >= firstSquaredKatakana && r <= lastSquaredKatakana:
+		return squaredKatakana[r-firstSquaredKatakana], true
+	}
+
+	ligature, ok := ligatures[r]
+	return ligature, ok
+}
@@ -0,0 +1,56 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnclosedKatakana(t *testing.T) {
+	input := []inp{
+		{input: "㋐", want: "a"},
+		{input: "㋕㋟", want: "kata"},
+		{input: "㋾", want: "wo"},
+		{input: "㌔", want: "kiro"},
+		{input: "㍉", want: "miri"},
+		{input: "㌀", want: "apaato"},
+		{input: "㍍", want: "meetoru"},
+		{input: "㌫", want: "paasento"},
+		{input: "ロ㌔", want: "rokiro"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestSquaredKatakanaAll(t *testing.T) {
+	for r := firstSquaredKatakana; r <= lastSquaredKatakana; r++ {
+		got, err := KanaToRomaji(string(r))
+		assert.NotEmpty(t, got, string(r))
+		assert.Nil(t, err, string(r))
+	}
+}
+
+func TestEnclosedKatakanaPosition(t *testing.T) {
+	got, err := KanaToRomaji("㌔㋿", WithPassthrough())
+	assert.Equal(t, "kiro㋿", got)
+	assert.Nil(t, err)
+
+	_, err = KanaToRomaji("あ㌔㋿")
+
+	var convErr *ConversionError
+	assert.ErrorAs(t, err, &convErr)
+	assert.Equal(t, ErrNotKana, convErr.Err)
+	assert.Equal(t, 2, convErr.Index)
+	assert.Equal(t, 6, convErr.Offset)
+}
+
+func TestExpandKana(t *testing.T) {
+	assert.Equal(t, "キロ", NormalizeKana("㌔"))
+	assert.Equal(t, "アイ", NormalizeKana("㋐㋑"))
+	assert.Equal(t, "㋿", NormalizeKana("㋿"))
+	assert.Equal(t, "きろ", KatakanaToHiragana("㌔"))
+}
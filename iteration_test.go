@@ -0,0 +1,104 @@
+package kanaconv
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterationMarks(t *testing.T) {
+	input := []inp{
+		{input: "いすゞ", want: "isuzu"},
+		{input: "こゝろ", want: "kokoro"},
+		{input: "バヽ", want: "baba"},
+		{input: "ハヾ", want: "haba"},
+		{input: "あゞ", want: "aa"},
+		{input: "たゝゝ", want: "tatata"},
+		{input: "ときゞ", want: "tokigi"},
+		{input: "わゞ", want: "wava"},
+		{input: "ほゝえむ", want: "hohoemu"},
+		{input: "ひと〻", want: "hitoto"},
+		{input: "きゃゝ", want: "kyaki"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestIterationMarksPassthrough(t *testing.T) {
+	input := []inp{
+		{input: "時〻", want: "時〻"},
+		{input: "a ゝ", want: ""},
+		{input: "いすゞ 自動車", want: "isuzu 自動車"},
+	}
+
+	for _, v := range input {
+		got, _ := KanaToRomaji(v.input, WithPassthrough())
+		assert.Equal(t, v.want, got)
+	}
+}
+
+func TestIterationMarkFirst(t *testing.T) {
+	_, err := KanaToRomaji("〻")
+	assert.True(t, errors.Is(err, ErrIterationMarkFirst))
+
+	input := []string{"ゝ", "ゞ", "ヽ", "ヾ", "ア ヽ"}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v, WithPassthrough())
+		assert.Empty(t, got)
+		assert.True(t, errors.Is(err, ErrIterationMarkFirst), v)
+	}
+}
+
+func TestIterationMarkFirstPosition(t *testing.T) {
+	_, err := KanaToRomaji("あ ゞ", WithPassthrough())
+
+	var convErr *ConversionError
+	assert.True(t, errors.As(err, &convErr))
+	assert.Equal(t, CodeIterationMarkFirst, convErr.Code)
+	assert.Equal(t, 4, convErr.Offset)
+	assert.Equal(t, 2, convErr.Index)
+	assert.Equal(t, 'ゞ', convErr.Rune)
+}
+
+func TestIterationMarksKanaToKana(t *testing.T) {
+	assert.Equal(t, "イスヾ", HiraganaToKatakana("いすゞ"))
+	assert.Equal(t, "イスズ", HiraganaToKatakana("いすゞ", WithIterationMarkExpansion()))
+	assert.Equal(t, "ばゝ", KatakanaToHiragana("バヽ"))
+	assert.Equal(t, "ばば", KatakanaToHiragana("バヽ", WithIterationMarkExpansion()))
+	assert.Equal(t, "わわ゙", KatakanaToHiragana("ワヾ", WithIterationMarkExpansion()))
+	assert.Equal(t, "ゝあ", KatakanaToHiragana("ヽア", WithIterationMarkExpansion()))
+	assert.Equal(t, "ヷヷ", HiraganaToKatakana("わ\u3099ゝ", WithIterationMarkExpansion()))
+	assert.Equal(t, "わ\u3099わ\u3099", KatakanaToHiragana("ヷヽ", WithIterationMarkExpansion()))
+}
+
+func TestIterationMarksKanaToKanaBase(t *testing.T) {
+	input := []inp{
+		{input: "か ゝ", want: "カ ヽ"},
+		{input: "漢ゝ", want: "漢ヽ"},
+		{input: "A〻", want: "A〻"},
+		{input: "か\u3099ゝ", want: "ガガ"},
+		{input: "か゛ゞ", want: "ガガ"},
+		{input: "きゃゝ", want: "キャキ"},
+		{input: "ｶﾞ㌔ゝ", want: "ｶﾞ㌔ロ"},
+	}
+
+	for _, v := range input {
+		assert.Equal(t, v.want, HiraganaToKatakana(v.input, WithIterationMarkExpansion()))
+	}
+
+	input = []inp{
+		{input: "カ ヽ", want: "か ゝ"},
+		{input: "漢ヽ", want: "漢ゝ"},
+		{input: "キャヽ", want: "きゃき"},
+	}
+
+	for _, v := range input {
+		assert.Equal(t, v.want, KatakanaToHiragana(v.input, WithIterationMarkExpansion()))
+	}
+}
@@ -0,0 +1,177 @@
+package kanaconv
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// offset between a hiragana and its katakana twin
+const katakanaOffset = 'ア' - 'あ'
+
+//	HiraganaToKatakana converts hiragana to katakana, other characters are kept as they are.
+//	Hiragana with a sound mark are combined (か゛ -> ガ), わ゙, ゐ゙, ゑ゙ and を゙ are converted to ヷ, ヸ, ヹ and ヺ, ゟ is converted to ヨリ.
+//	Iteration marks are kept (ゝ -> ヽ) unless WithIterationMarkExpansion is passed (すゞ -> スズ),
+//	an iteration mark which does not follow kana is kept as well.
+func HiraganaToKatakana(str string, opts ...Option) string {
+	o := newOptions(opts)
+
+	var sb strings.Builder
+	sb.Grow(len(str))
+
+	// rBase is the kana which an iteration mark repeats
+	var rBase rune
+	runes := decodeKana(str)
+	for i, kana := range runes {
+		r := kana.r
+		if o.expandsIterationMarks && rBase != 0 && isIterationMark(r) {
+			r = iterate(rBase, r)
+		}
+		rBase = iterationBase(rBase, r)
+
+		first, _ := utf8.DecodeRuneInString(kana.source)
+		switch {
+		case r != kana.r || IsHiragana(first):
+			sb.WriteRune(toKatakana(r))
+		case i == 0 || runes[i-1].offset != kana.offset:
+			// the expanded kana share the source, it is written once (ｶ, ㌔)
+			sb.WriteString(kana.source)
+		}
+	}
+
+	return sb.String()
+}
+
+//	KatakanaToHiragana converts katakana (including half-width katakana) to hiragana, other characters are kept as they are.
+//	ヷ, ヸ, ヹ and ヺ have no hiragana, therefore they are converted to わ゙, ゐ゙, ゑ゙ and を゙, ligatures, enclosed and squared katakana
+//	are expanded (ヿ -> こと, ㌔ -> きろ).
+//	Chōonpu is kept unless WithChouonpuExpansion is passed (ラーメン -> らあめん),
+//	iteration marks are kept (ヽ -> ゝ) unless WithIterationMarkExpansion is passed (バヽ -> ばば),
+//	an iteration mark which does not follow kana is kept as well.
+func KatakanaToHiragana(str string, opts ...Option) string {
+	o := newOptions(opts)
+
+	var sb strings.Builder
+	sb.Grow(len(str))
+
+	// rBase is the kana which an iteration mark repeats
+	var rPrev, rVowel, rBase rune
+	for _, kana := range decodeKana(str) {
+		r := kana.r
+		if o.expandsIterationMarks && rBase != 0 && isIterationMark(r) {
+			r = iterate(rBase, r)
+		}
+		rBase = iterationBase(rBase, r)
+
+		switch r {
+		case 'ヷ', 'ヸ', 'ヹ', 'ヺ':
+			rPrev = r - 'ヷ' + 'わ'
+			sb.WriteRune(rPrev)
+			sb.WriteRune(combiningDakuten)
+			continue
+		case 'ー':
+			if o.expandsChouonpu {
+				if rPrev != 'ー' {
+					rVowel = hiraganaVowel(rPrev)
+				}
+
+				rPrev = r
+				if rVowel != 0 {
+					sb.WriteRune(rVowel)
+					continue
+				}
+			}
+		}
+
+		rPrev = toHiragana(r)
+		sb.WriteRune(rPrev)
+	}
+
+	return sb.String()
+}
+
+//	IsHiragana checks whether the rune is a hiragana character.
+func IsHiragana(r rune) bool {
+	return (r >= 'ぁ' && r <= 'ゖ') || r == 'ゝ' || r == 'ゞ' || r == 'ゟ'
+}
+
+//	IsKatakana checks whether the rune is a katakana character (including chōonpu, small Ainu kana and half-width katakana).
+func IsKatakana(r rune) bool {
+	return (r >= 'ァ' && r <= 'ヺ') || r == 'ー' || r == 'ヽ' || r == 'ヾ' || r == 'ヿ' || (r >= 'ㇰ' && r <= 'ㇿ') || (r >= 'ｦ' && r <= 'ﾝ')
+}
+
+//	IsKana checks whether the rune is a hiragana or a katakana character.
+func IsKana(r rune) bool {
+	return IsHiragana(r) || IsKatakana(r)
+}
+
+//	toKatakana converts a hiragana rune to its katakana twin
+func toKatakana(r rune) rune {
+	switch {
+	case r >= 'ぁ' && r <= 'ゖ', r == 'ゝ', r == 'ゞ':
+		return r + katakanaOffset
+	default:
+		return r
+	}
+}
+
+//	toHiragana converts a katakana rune to its hiragana twin
+func toHiragana(r rune) rune {
+	switch {
+	case r >= 'ァ' && r <= 'ヶ', r == 'ヽ', r == 'ヾ':
+		return r - katakanaOffset
+	default:
+		return r
+	}
+}
+
+//	voicedW returns the katakana of わ, ゐ, ゑ and を with dakuten
+func voicedW(r rune) (rune, bool) {
+	switch r {
+	case 'わ', 'ワ':
+		return 'ヷ', true
+	case 'ゐ', 'ヰ':
+		return 'ヸ', true
+	case 'ゑ', 'ヱ':
+		return 'ヹ', true
+	case 'を', 'ヲ':
+		return 'ヺ', true
+	default:
+		return 0, false
+	}
+}
+
+//	hiraganaVowel returns the vowel which extends the hiragana, or 0 if it cannot be extended
+func hiraganaVowel(r rune) rune {
+	switch r {
+	case 'ぁ', 'ゃ', 'ゎ':
+		return 'あ'
+	case 'ぃ':
+		return 'い'
+	case 'ぅ', 'ゅ':
+		return 'う'
+	case 'ぇ':
+		return 'え'
+	case 'ぉ', 'ょ':
+		return 'お'
+	}
+
+	romaji, err := KanaToRomaji(string(r))
+	if err != nil || len(romaji) == 0 {
+		return 0
+	}
+
+	switch romaji[len(romaji)-1] {
+	case 'a':
+		return 'あ'
+	case 'i':
+		return 'い'
+	case 'u':
+		return 'う'
+	case 'e':
+		return 'え'
+	case 'o':
+		return 'お'
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,96 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHiraganaToKatakana(t *testing.T) {
+	input := []inp{
+		{input: "ひらがな", want: "ヒラガナ"},
+		{input: "ぁぃぅぇぉっゃゅょゎゕゖ", want: "ァィゥェォッャュョヮヵヶ"},
+		{input: "ゔ", want: "ヴ"},
+		{input: "ゝゞ", want: "ヽヾ"},
+		{input: "わ゙ゐ゙ゑ゙を゙", want: "ヷヸヹヺ"},
+		{input: "らあめん", want: "ラアメン"},
+		{input: "日本のカタカナ!", want: "日本ノカタカナ!"},
+	}
+
+	for _, v := range input {
+		got := HiraganaToKatakana(v.input)
+		assert.Equal(t, v.want, got)
+	}
+}
+
+func TestKatakanaToHiragana(t *testing.T) {
+	input := []inp{
+		{input: "カタカナ", want: "かたかな"},
+		{input: "ァィゥェォッャュョヮヵヶ", want: "ぁぃぅぇぉっゃゅょゎゕゖ"},
+		{input: "ヴ", want: "ゔ"},
+		{input: "ヽヾ", want: "ゝゞ"},
+		{input: "ヷヸヹヺ", want: "わ゙ゐ゙ゑ゙を゙"},
+		{input: "ラーメン", want: "らーめん"},
+		{input: "日本のカタカナ!", want: "日本のかたかな!"},
+	}
+
+	for _, v := range input {
+		got := KatakanaToHiragana(v.input)
+		assert.Equal(t, v.want, got)
+	}
+}
+
+func TestKatakanaToHiraganaChouonpu(t *testing.T) {
+	input := []inp{
+		{input: "ラーメン", want: "らあめん"},
+		{input: "スーパー", want: "すうぱあ"},
+		{input: "コーヒー", want: "こおひい"},
+		{input: "ショー", want: "しょお"},
+		{input: "ケーキ", want: "けえき"},
+		{input: "ヷー", want: "わ゙あ"},
+		{input: "ンー", want: "んー"},
+		{input: "ー", want: "ー"},
+		{input: "アーー", want: "あああ"},
+	}
+
+	for _, v := range input {
+		got := KatakanaToHiragana(v.input, WithChouonpuExpansion())
+		assert.Equal(t, v.want, got)
+	}
+}
+
+func TestKanaCategory(t *testing.T) {
+	for _, r := range "ぁあゖゝゞゟ" {
+		assert.True(t, IsHiragana(r))
+		assert.False(t, IsKatakana(r))
+		assert.True(t, IsKana(r))
+	}
+
+	for _, r := range "ァアヶヷヺーヽヾヿ" {
+		assert.False(t, IsHiragana(r))
+		assert.True(t, IsKatakana(r))
+		assert.True(t, IsKana(r))
+	}
+
+	for _, r := range "日a・。" {
+		assert.False(t, IsKana(r))
+	}
+}
+
+func TestWDakutenKana(t *testing.T) {
+	for _, r := range "ヷヸヹヺ" {
+		assert.True(t, IsKatakana(r))
+		assert.True(t, IsKana(r))
+		assert.False(t, IsHiragana(r))
+	}
+
+	got := HiraganaToKatakana(KatakanaToHiragana("ヷヸヹヺ"))
+	assert.Equal(t, "ヷヸヹヺ", got)
+}
+
+func TestLigatureKana(t *testing.T) {
+	assert.Equal(t, "ヨリ", HiraganaToKatakana("ゟ"))
+	assert.Equal(t, "ヿ", HiraganaToKatakana("ヿ"))
+	assert.Equal(t, "こと", KatakanaToHiragana("ヿ"))
+	assert.Equal(t, "ことと", KatakanaToHiragana("ヿヽ", WithIterationMarkExpansion()))
+}
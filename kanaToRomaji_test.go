@@ -242,7 +242,7 @@ func TestYouonK(t *testing.T) {
 }
 
 func TestYouonKSpecial(t *testing.T) {
-	const want = "kakikukekokwaki"
+	const want = "kwakwikukwekwokwaki"
 
 	for _, v := range [2]string{"くぁくぃくぅくぇくぉくゎけぃ", "クァクィクゥクェクォクヮケィ"} {
 		got, err := KanaToRomaji(v)
@@ -262,7 +262,7 @@ func TestYouonG(t *testing.T) {
 }
 
 func TestYouonGSpecial(t *testing.T) {
-	const want = "gagigugegogwagi"
+	const want = "gwagwigugwegwogwagi"
 
 	for _, v := range [2]string{"ぐぁぐぃぐぅぐぇぐぉぐゎげぃ", "グァグィグゥグェグォグヮゲィ"} {
 		got, err := KanaToRomaji(v)
@@ -852,3 +852,95 @@ func TestNonKanaCharacters(t *testing.T) {
 		assert.NotNil(t, err)
 	}
 }
+
+func TestWDakuten(t *testing.T) {
+	input := []inp{
+		{input: "ヷヸヹヺ", want: "vavivevo"},
+		{input: "ッヷ", want: "vva"},
+		{input: "ッヸ", want: "vvi"},
+		{input: "ヷー", want: "vaa"},
+		{input: "ヺー", want: "voo"},
+		{input: "ヸャ", want: "vya"},
+		{input: "ヸョ", want: "vyo"},
+		{input: "ヷイオリン", want: "vaiorin"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestWDakutenConsistency(t *testing.T) {
+	input := []inp{
+		{input: "ヷ", want: "ヴァ"},
+		{input: "ヸ", want: "ヴィ"},
+		{input: "ヹ", want: "ヴェ"},
+		{input: "ヺ", want: "ヴォ"},
+		{input: "ッヷー", want: "ッヴァー"},
+	}
+
+	for _, v := range input {
+		want, err := KanaToRomaji(v.want)
+		assert.Nil(t, err)
+
+		got, err := KanaToRomaji(v.input)
+		assert.Equal(t, want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestSmallK(t *testing.T) {
+	input := []inp{
+		{input: "ゕゖ", want: "kake"},
+		{input: "ヵヶ", want: "kake"},
+		{input: "ッヵ", want: "kka"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestSmallKCounter(t *testing.T) {
+	input := []inp{
+		{input: "霞ヶ関と一ヶ月", want: "霞ga関to一ka月"},
+		{input: "霞ヶ関", want: "霞ga関"},
+		{input: "一ヶ月", want: "一ka月"},
+		{input: "三ヵ所", want: "三ka所"},
+		{input: "3ヶ月", want: "3ka月"},
+		{input: "３ヶ月", want: "３ka月"},
+		{input: "何ヶ国", want: "何ka国"},
+		{input: "ヶ月", want: "ke月"},
+		{input: "一ヶ", want: "一ke"},
+		{input: "あヶ月", want: "ake月"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithPassthrough(), WithCounterKana())
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+
+	got, err := KanaToRomaji("霞ヶ関と一ヶ月", WithPassthrough())
+	assert.Equal(t, "霞ke関to一ke月", got)
+	assert.Nil(t, err)
+}
+
+func TestLigatures(t *testing.T) {
+	input := []inp{
+		{input: "ゟ", want: "yori"},
+		{input: "ヿ", want: "koto"},
+		{input: "これゟ", want: "koreyori"},
+		{input: "ヿゝ", want: "kototo"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
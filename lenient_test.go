@@ -0,0 +1,87 @@
+package kanaconv
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLenient(t *testing.T) {
+	input := []struct {
+		input       string
+		replacement Replacement
+		want        string
+		codes       []ErrorCode
+	}{
+		{input: "あい日本うえ", replacement: ReplaceWithOriginal, want: "ai日本ue", codes: []ErrorCode{CodeNotKana, CodeNotKana}},
+		{input: "あい日本うえ", replacement: ReplaceWithQuestionMark, want: "ai??ue", codes: []ErrorCode{CodeNotKana, CodeNotKana}},
+		{input: "あい日本うえ", replacement: ReplaceWithNothing, want: "aiue", codes: []ErrorCode{CodeNotKana, CodeNotKana}},
+		{input: "ゃきっあ", replacement: ReplaceWithQuestionMark, want: "?ki?a", codes: []ErrorCode{CodeYouonFirst, CodeSokuonBeforeVowel}},
+		{input: "かんーな", replacement: ReplaceWithQuestionMark, want: "kan?na", codes: []ErrorCode{CodeChouonpuConsonant}},
+		{input: "あゃか", replacement: ReplaceWithNothing, want: "aka", codes: []ErrorCode{CodeYouonCombination}},
+		{input: "ab", replacement: ReplaceWithOriginal, want: "ab", codes: []ErrorCode{CodeNotKana, CodeNotKana}},
+		{input: "ｱ｡ｶ", replacement: ReplaceWithOriginal, want: "a｡ka", codes: []ErrorCode{CodeNotKana}},
+		{input: "ｬｶﾞ", replacement: ReplaceWithOriginal, want: "ｬga", codes: []ErrorCode{CodeYouonFirst}},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithLenient(v.replacement))
+		assert.Equal(t, v.want, got)
+
+		var convErrs ConversionErrors
+		if assert.ErrorAs(t, err, &convErrs) {
+			codes := make([]ErrorCode, len(convErrs))
+			for i, convErr := range convErrs {
+				codes[i] = convErr.Code
+			}
+
+			assert.Equal(t, v.codes, codes)
+		}
+	}
+}
+
+func TestLenientErrors(t *testing.T) {
+	got, err := KanaToRomaji("ーかっあ", WithLenient(ReplaceWithQuestionMark))
+	assert.Equal(t, "?ka?a", got)
+	assert.True(t, errors.Is(err, ErrChouonpuFirst))
+	assert.True(t, errors.Is(err, ErrSokuonBeforeVowel))
+	assert.False(t, errors.Is(err, ErrNotKana))
+	assert.EqualError(t, err, "chōonpu cannot be the first character in a block (at 0); sokuon cannot precede a vowel (at 2)")
+}
+
+func TestLenientSokuonBeforeVowel(t *testing.T) {
+	input := []struct {
+		input       string
+		replacement Replacement
+		want        string
+	}{
+		{input: "っあ", replacement: ReplaceWithOriginal, want: "っa"},
+		{input: "っあ", replacement: ReplaceWithQuestionMark, want: "?a"},
+		{input: "えっあ", replacement: ReplaceWithOriginal, want: "eっa"},
+		{input: "えっあか", replacement: ReplaceWithNothing, want: "eaka"},
+		{input: "かっあー", replacement: ReplaceWithQuestionMark, want: "ka?aa"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithLenient(v.replacement))
+		assert.Equal(t, v.want, got)
+		assert.ErrorIs(t, err, ErrSokuonBeforeVowel)
+	}
+}
+
+func TestLenientValid(t *testing.T) {
+	got, err := KanaToRomaji("かな", WithLenient(ReplaceWithQuestionMark))
+	assert.Equal(t, "kana", got)
+	assert.Nil(t, err)
+}
+
+func TestLenientRomaji(t *testing.T) {
+	got, err := RomajiToHiragana("ka!na?", WithLenient(ReplaceWithOriginal))
+	assert.Equal(t, "か!な?", got)
+
+	var convErrs ConversionErrors
+	assert.ErrorAs(t, err, &convErrs)
+	assert.Len(t, convErrs, 2)
+	assert.True(t, errors.Is(err, ErrNotRomaji))
+}
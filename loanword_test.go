@@ -0,0 +1,116 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//	loanwordTable1 are the kana of the table 1 of the 1991 cabinet notice "外来語の表記",
+//	they are used for common loanwords
+var loanwordTable1 = []inp{
+	{input: "シェ", want: "she"},
+	{input: "チェ", want: "che"},
+	{input: "ツァ", want: "tsa"},
+	{input: "ツェ", want: "tse"},
+	{input: "ツォ", want: "tso"},
+	{input: "ティ", want: "ti"},
+	{input: "ファ", want: "fa"},
+	{input: "フィ", want: "fi"},
+	{input: "フェ", want: "fe"},
+	{input: "フォ", want: "fo"},
+	{input: "ジェ", want: "je"},
+	{input: "ディ", want: "di"},
+	{input: "デュ", want: "dyu"},
+}
+
+//	loanwordTable2 are the kana of the table 2 of the notice, they are used to write the original sound closer
+var loanwordTable2 = []inp{
+	{input: "イェ", want: "ye"},
+	{input: "ウィ", want: "wi"},
+	{input: "ウェ", want: "we"},
+	{input: "ウォ", want: "wo"},
+	{input: "クァ", want: "kwa"},
+	{input: "クィ", want: "kwi"},
+	{input: "クェ", want: "kwe"},
+	{input: "クォ", want: "kwo"},
+	{input: "ツィ", want: "tsi"},
+	{input: "トゥ", want: "tu"},
+	{input: "グァ", want: "gwa"},
+	{input: "ドゥ", want: "du"},
+	{input: "ヴァ", want: "va"},
+	{input: "ヴィ", want: "vi"},
+	{input: "ヴ", want: "vu"},
+	{input: "ヴェ", want: "ve"},
+	{input: "ヴォ", want: "vo"},
+	{input: "テュ", want: "tyu"},
+	{input: "フュ", want: "fyu"},
+	{input: "ヴュ", want: "vyu"},
+}
+
+//	loanwordOther are the combinations which are not in the notice but are common in loanwords
+var loanwordOther = []inp{
+	{input: "スィ", want: "si"},
+	{input: "ズィ", want: "zi"},
+	{input: "グィ", want: "gwi"},
+	{input: "グェ", want: "gwe"},
+	{input: "グォ", want: "gwo"},
+	{input: "クヮ", want: "kwa"},
+	{input: "グヮ", want: "gwa"},
+	{input: "キェ", want: "kye"},
+	{input: "ニェ", want: "nye"},
+}
+
+func TestLoanwords(t *testing.T) {
+	for _, table := range [][]inp{loanwordTable1, loanwordTable2, loanwordOther} {
+		for _, v := range table {
+			got, err := KanaToRomaji(v.input)
+			assert.Equal(t, v.want, got, v.input)
+			assert.Nil(t, err, v.input)
+
+			got, err = KanaToRomaji(KatakanaToHiragana(v.input))
+			assert.Equal(t, v.want, got, v.input)
+			assert.Nil(t, err, v.input)
+		}
+	}
+}
+
+func TestLoanwordsRomajiToKatakana(t *testing.T) {
+	for _, table := range [][]inp{loanwordTable1, loanwordTable2} {
+		for _, v := range table {
+			got, err := RomajiToKatakana(v.want)
+			assert.Equal(t, v.input, got, v.want)
+			assert.Nil(t, err, v.want)
+		}
+	}
+}
+
+func TestLoanwordsScheme(t *testing.T) {
+	for _, scheme := range []Scheme{KunreiShiki, NihonShiki, ISO3602} {
+		for _, table := range [][]inp{loanwordTable1, loanwordTable2} {
+			for _, v := range table {
+				got, err := KanaToRomaji(v.input, WithScheme(scheme))
+				assert.Equal(t, v.want, got, v.input)
+				assert.Nil(t, err, v.input)
+			}
+		}
+	}
+}
+
+func TestLoanwordWords(t *testing.T) {
+	input := []inp{
+		{input: "クァルテット", want: "kwarutetto"},
+		{input: "グァテマラ", want: "gwatemara"},
+		{input: "フューチャー", want: "fyuuchaa"},
+		{input: "ヴュー", want: "vyuu"},
+		{input: "イェーガー", want: "yeegaa"},
+		{input: "トゥモロー", want: "tumoroo"},
+		{input: "ツィター", want: "tsitaa"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
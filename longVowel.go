@@ -0,0 +1,103 @@
+package kanaconv
+
+import "unicode"
+
+//	LongVowel is the way long vowels are written in romaji.
+//	It applies to chōonpu (ー) and to the hiragana vowel pairs ああ, うう, ええ, おお and おう.
+//	Following the modified Hepburn, いい and えい are not treated as long vowels.
+type LongVowel int8
+
+const (
+	//	LongVowelDoubled writes chōonpu as a doubled vowel and vowel pairs as they are (ラーメン -> raamen, とうきょう -> toukyou). It is the default.
+	LongVowelDoubled LongVowel = iota
+	//	LongVowelMacron writes long vowels with a macron (ā, ī, ū, ē, ō).
+	LongVowelMacron
+	//	LongVowelCircumflex writes long vowels with a circumflex (â, î, û, ê, ô).
+	LongVowelCircumflex
+	//	LongVowelH writes long vowels with a trailing "h" (ah, ih, uh, eh, oh).
+	LongVowelH
+
+	// passport spellings omit long o and u, longVowelPassportOH writes long o as "oh"
+	longVowelPassport
+	longVowelPassportOH
+)
+
+const (
+	vowels       = "aiueo"
+	macrons      = "āīūēō"
+	circumflexes = "âîûêô"
+)
+
+//	extend writes the last vowel of the syllable as a long vowel.
+func (lv LongVowel) extend(syllable string) string {
+	last := len(syllable) - 1
+	vowel := syllable[last]
+
+	switch lv {
+	case LongVowelMacron:
+		return syllable[:last] + string(longVowelRune(macrons, vowel))
+	case LongVowelCircumflex:
+		return syllable[:last] + string(longVowelRune(circumflexes, vowel))
+	case LongVowelH:
+		return syllable + "h"
+	case longVowelPassport, longVowelPassportOH:
+		switch vowel {
+		case 'o':
+			if lv == longVowelPassportOH {
+				return syllable + "h"
+			}
+			return syllable
+		case 'u':
+			return syllable
+		default:
+			return syllable + string(vowel)
+		}
+	default:
+		return syllable + string(vowel)
+	}
+}
+
+func longVowelRune(marked string, vowel byte) rune {
+	i := 0
+	for _, r := range marked {
+		if vowels[i] == vowel {
+			return r
+		}
+		i++
+	}
+
+	panic("unsupported vowel passed")
+}
+
+//	isLongVowelPair checks whether the hiragana vowel extends the preceding vowel.
+func isLongVowelPair(prevVowel byte, kana rune) bool {
+	switch kana {
+	case 'あ':
+		return prevVowel == 'a'
+	case 'う':
+		return prevVowel == 'u' || prevVowel == 'o'
+	case 'え':
+		return prevVowel == 'e'
+	case 'お':
+		return prevVowel == 'o'
+	default:
+		return false
+	}
+}
+
+//	longVowelBase returns the vowel of a long vowel written with a macron or a circumflex.
+func longVowelBase(r rune) (byte, bool) {
+	r = unicode.ToLower(r)
+
+	for _, marked := range [...]string{macrons, circumflexes} {
+		i := 0
+		for _, m := range marked {
+			if m == r {
+				return vowels[i], true
+			}
+			i++
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,81 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLongVowelMacron(t *testing.T) {
+	input := []inp{
+		{input: "ラーメン", want: "rāmen"},
+		{input: "とうきょう", want: "tōkyō"},
+		{input: "おおさか", want: "ōsaka"},
+		{input: "おかあさん", want: "okāsan"},
+		{input: "くうき", want: "kūki"},
+		{input: "おねえさん", want: "onēsan"},
+		{input: "おにいさん", want: "oniisan"},
+		{input: "せんせい", want: "sensei"},
+		{input: "スーパー", want: "sūpā"},
+		{input: "ビール", want: "bīru"},
+		{input: "ソウル", want: "souru"},
+		{input: "おおう", want: "ōu"},
+		{input: "ぎゅうにゅう", want: "gyūnyū"},
+		{input: "こーひー", want: "kōhī"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithLongVowel(LongVowelMacron))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestLongVowelCircumflex(t *testing.T) {
+	input := []inp{
+		{input: "ラーメン", want: "râmen"},
+		{input: "とうきょう", want: "tôkyô"},
+		{input: "くうき", want: "kûki"},
+		{input: "ビール", want: "bîru"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithLongVowel(LongVowelCircumflex))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestLongVowelH(t *testing.T) {
+	input := []inp{
+		{input: "おおの", want: "ohno"},
+		{input: "さとう", want: "satoh"},
+		{input: "ラーメン", want: "rahmen"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithLongVowel(LongVowelH))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestLongVowelDoubled(t *testing.T) {
+	input := []inp{
+		{input: "ラーメン", want: "raamen"},
+		{input: "とうきょう", want: "toukyou"},
+		{input: "おおさか", want: "oosaka"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithLongVowel(LongVowelDoubled))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestLongVowelSchemes(t *testing.T) {
+	got, err := KanaToRomaji("しょうゆ", WithScheme(ISO3602), WithLongVowel(LongVowelCircumflex))
+	assert.Equal(t, "syôyu", got)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,49 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNSeparatorApostrophe(t *testing.T) {
+	input := []inp{
+		{input: "きんえん", want: "kin'en"},
+		{input: "きねん", want: "kinen"},
+		{input: "かんや", want: "kan'ya"},
+		{input: "かにゃ", want: "kanya"},
+		{input: "しんいち", want: "shin'ichi"},
+		{input: "ほんよう", want: "hon'you"},
+		{input: "こんにちは", want: "konnichiha"},
+		{input: "せんぱい", want: "senpai"},
+		{input: "ペンギン", want: "pengin"},
+		{input: "キンエン", want: "kin'en"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithNSeparator("'"))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestNSeparatorHyphen(t *testing.T) {
+	input := []inp{
+		{input: "きんえん", want: "kin-en"},
+		{input: "かんや", want: "kan-ya"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithNSeparator("-"))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestNSeparatorNone(t *testing.T) {
+	for _, v := range []string{"きんえん", "きねん"} {
+		got, err := KanaToRomaji(v)
+		assert.Equal(t, "kinen", got)
+		assert.Nil(t, err)
+	}
+}
@@ -0,0 +1,33 @@
+package kanaconv
+
+import "strings"
+
+//	NormalizeKana composes sound marks and expands compatibility kana the way KanaToRomaji reads them.
+//	It is similar to NFKC, but half-width katakana are not converted.
+//	Kana followed by a combining or spacing sound mark is replaced with its voiced or semi-voiced twin (か゛ -> が, は゜ -> ぱ).
+//	Ligatures, enclosed and squared katakana are expanded (ゟ -> より, ㋐ -> ア, ㌔ -> キロ).
+//	Sequences which have no precomposed character (わ゙) and half-width katakana are kept as they are.
+func NormalizeKana(str string) string {
+	var sb strings.Builder
+	sb.Grow(len(str))
+
+	runes := []rune(str)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if i+1 < len(runes) && !isHalfwidth(r) && runes[i+1] != halfwidthDakuten && runes[i+1] != halfwidthHandakuten {
+			if composed, ok := withSoundMark(r, runes[i+1]); ok && IsHiragana(composed) == IsHiragana(r) {
+				r = composed
+				i++
+			}
+		}
+
+		if expanded, ok := expandKana(r); ok {
+			sb.WriteString(expanded)
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,59 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecomposedKana(t *testing.T) {
+	input := []inp{
+		{input: "が", want: "ga"},
+		{input: "ぱ", want: "pa"},
+		{input: "ガラス", want: "garasu"},
+		{input: "か゛き", want: "gaki"},
+		{input: "ハ゜ン", want: "pan"},
+		{input: "ウ゛", want: "vu"},
+		{input: "ゔ", want: "vu"},
+		{input: "ワ゛", want: "va"},
+		{input: "ヺ", want: "vo"},
+		{input: "わ゙", want: "va"},
+		{input: "いすゞ", want: "isuzu"},
+		{input: "ﾊ゚", want: "pa"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestDecomposedKanaInvalid(t *testing.T) {
+	input := []string{"あ゙", "゛", "か゚"}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v)
+		assert.Empty(t, got)
+		assert.ErrorIs(t, err, ErrNotKana, v)
+	}
+}
+
+func TestNormalizeKana(t *testing.T) {
+	input := []inp{
+		{input: "が", want: "が"},
+		{input: "ぱん", want: "ぱん"},
+		{input: "ウ゛ァイオリン", want: "ヴァイオリン"},
+		{input: "ヷ", want: "ヷ"},
+		{input: "わ゙", want: "わ゙"},
+		{input: "ゞ", want: "ゞ"},
+		{input: "あ゙", want: "あ゙"},
+		{input: "ﾊﾟ", want: "ﾊﾟ"},
+		{input: "がき Go", want: "がき Go"},
+	}
+
+	for _, v := range input {
+		got := NormalizeKana(v.input)
+		assert.Equal(t, v.want, got)
+	}
+}
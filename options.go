@@ -0,0 +1,179 @@
+package kanaconv
+
+//	Option configures a conversion function, e.g. KanaToRomaji.
+type Option func(*options)
+
+type options struct {
+	scheme                Scheme
+	longVowel             LongVowel
+	labialN               bool
+	nSeparator            string
+	casing                Casing
+	isChouonpu            bool
+	expandsChouonpu       bool
+	isHalfwidthHiragana   bool
+	isPassthrough         bool
+	isLenient             bool
+	replacement           Replacement
+	danglingSokuon        DanglingSokuon
+	readsCounterKana      bool
+	expandsIterationMarks bool
+	isAinu                bool
+	punctuation           Punctuation
+	isParticleAware       bool
+	segmenter             Segmenter
+	table                 *tableIndex
+	tableErr              error
+}
+
+func newOptions(opts []Option) *options {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+//	WithScheme sets the romanization scheme used by KanaToRomaji (Hepburn by default).
+func WithScheme(scheme Scheme) Option {
+	return func(o *options) {
+		o.scheme = scheme
+	}
+}
+
+//	WithLongVowel sets the way long vowels are written by KanaToRomaji (LongVowelDoubled by default).
+func WithLongVowel(longVowel LongVowel) Option {
+	return func(o *options) {
+		o.longVowel = longVowel
+	}
+}
+
+//	WithNSeparator sets the separator written after ん before a vowel or "y", e.g. "'" (きんえん -> kin'en, かんや -> kan'ya).
+//	By default ん is always written as "n".
+func WithNSeparator(separator string) Option {
+	return func(o *options) {
+		o.nSeparator = separator
+	}
+}
+
+//	WithPassport makes KanaToRomaji spell names the way Japanese passports do (the Hepburn rules of the Ministry of Foreign Affairs):
+//	long o and u are not written (おお, おう -> O, うう -> U), or written as "OH" if oh is true,
+//	ん before b, m and p is written as "M" (なんば -> NAMBA) and the output is in uppercase.
+func WithPassport(oh bool) Option {
+	return func(o *options) {
+		o.scheme = Hepburn
+		o.longVowel = longVowelPassport
+		if oh {
+			o.longVowel = longVowelPassportOH
+		}
+
+		o.labialN = true
+		o.casing = CasingUpper
+	}
+}
+
+//	WithChouonpu makes RomajiToKatakana convert doubled vowels to chōonpu (raamen -> ラーメン).
+func WithChouonpu() Option {
+	return func(o *options) {
+		o.isChouonpu = true
+	}
+}
+
+//	WithChouonpuExpansion makes KatakanaToHiragana write chōonpu as the vowel it extends (ラーメン -> らあめん).
+func WithChouonpuExpansion() Option {
+	return func(o *options) {
+		o.expandsChouonpu = true
+	}
+}
+
+//	WithHalfwidthHiragana makes ToHalfwidthKatakana convert hiragana to half-width katakana as well.
+func WithHalfwidthHiragana() Option {
+	return func(o *options) {
+		o.isHalfwidthHiragana = true
+	}
+}
+
+//	WithPassthrough makes KanaToRomaji copy non-kana characters (kanji, latin, digits, whitespace, etc.) as they are
+//	instead of returning an error. Each run of kana is a separate kana block.
+func WithPassthrough() Option {
+	return func(o *options) {
+		o.isPassthrough = true
+	}
+}
+
+//	WithLenient makes a conversion continue past characters which cannot be converted.
+//	They are replaced according to the replacement and the partial result is returned together with ConversionErrors.
+func WithLenient(replacement Replacement) Option {
+	return func(o *options) {
+		o.isLenient = true
+		o.replacement = replacement
+	}
+}
+
+//	WithDanglingSokuon sets the way KanaToRomaji writes sokuon which is not followed by a consonant (DanglingSokuonDefault by default).
+func WithDanglingSokuon(danglingSokuon DanglingSokuon) Option {
+	return func(o *options) {
+		o.danglingSokuon = danglingSokuon
+	}
+}
+
+//	WithCounterKana makes KanaToRomaji read ヶ and ヵ from the context when they are followed by a kanji:
+//	"ka" after a number (一ヶ月, 3ヶ所), "ga" after another kanji (霞ヶ関).
+//	The kanji are usually passed through with WithPassthrough. Otherwise ヵ is read as "ka" and ヶ as "ke".
+func WithCounterKana() Option {
+	return func(o *options) {
+		o.readsCounterKana = true
+	}
+}
+
+//	WithIterationMarkExpansion makes HiraganaToKatakana and KatakanaToHiragana replace iteration marks
+//	with the kana they repeat (すゞ -> スズ, バヽ -> ばば).
+func WithIterationMarkExpansion() Option {
+	return func(o *options) {
+		o.expandsIterationMarks = true
+	}
+}
+
+//	WithAinu makes KanaToRomaji transliterate Ainu kana with the standard Ainu orthography (アイヌㇷ゚ -> aynup).
+//	The small kana of the Katakana Phonetic Extensions become syllable-final consonants, ト゚ is "tu" and セ゚ is "ce".
+func WithAinu() Option {
+	return func(o *options) {
+		o.isAinu = true
+	}
+}
+
+//	WithPunctuation makes KanaToRomaji write Japanese punctuation with the mapping (e.g. PunctuationASCII()),
+//	punctuation which is not in the mapping is treated as any other non-kana character.
+func WithPunctuation(punctuation Punctuation) Option {
+	return func(o *options) {
+		o.punctuation = punctuation
+	}
+}
+
+//	WithParticles makes KanaToRomaji write the particles は, へ and を as they are pronounced (wa, e, o).
+//	The text is split into words with the segmenter or with spaces if the segmenter is nil,
+//	only a word which is a particle on its own is converted by its pronunciation.
+//	The words are written separated with a space (わたし は がくせい -> watashi wa gakusei).
+func WithParticles(segmenter Segmenter) Option {
+	return func(o *options) {
+		o.isParticleAware = true
+		o.segmenter = segmenter
+	}
+}
+
+//	WithCasing sets the capitalization of the romaji written by KanaToRomaji (CasingLower by default).
+//	WithPassport sets CasingUpper, pass WithCasing after it to change it.
+func WithCasing(casing Casing) Option {
+	return func(o *options) {
+		o.casing = casing
+	}
+}
+
+//	WithTable makes KanaToRomaji use the table instead of the built-in rules for the kana it contains,
+//	e.g. a DefaultTable() with "つ" set to "tu". KanaToRomaji returns a *TableError if the table is not valid (see Table.Validate).
+func WithTable(table Table) Option {
+	return func(o *options) {
+		o.table, o.tableErr = table.index()
+	}
+}
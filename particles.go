@@ -0,0 +1,158 @@
+package kanaconv
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//	Segmenter splits the text into words, the words are expected to be substrings of the text in their order.
+//	Punctuation can be returned as separate words, whitespace between the words can be left out.
+type Segmenter func(str string) []string
+
+//	particles maps the particles to the kana of their pronunciation
+var particles = map[string]string{
+	"は": "わ",
+	"へ": "え",
+	"を": "お",
+}
+
+//	particlesToRomaji converts every word on its own, particles are converted by their pronunciation (は -> wa).
+//	The whitespace between the words is kept, a space is written only between words which the segmenter returned next to each other.
+//	Error positions point at the character in the whole text.
+func particlesToRomaji(str string, o *options) (string, error) {
+	var words []string
+	if o.segmenter != nil {
+		words = o.segmenter(str)
+	} else {
+		words = strings.Fields(str)
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(str) * 2)
+
+	var convErrs ConversionErrors
+	// isWord is set when the last written text is a word (not punctuation or whitespace)
+	var isWord bool
+	// space is the trailing space of the punctuation which ends the last word (。 -> ". "),
+	// it is trimmed at the end of the word and written only if another word follows
+	var space string
+	offset := 0
+	for _, word := range words {
+		// a word which is not found in the text (e.g. changed by the segmenter) keeps the last position
+		start := offset
+		if i := strings.Index(str[offset:], word); i != -1 {
+			start = offset + i
+			if o.writeSeparator(&sb, str[offset:start]) {
+				isWord, space = false, ""
+			}
+
+			offset = start + len(word)
+		}
+
+		if len(strings.TrimSpace(word)) == 0 {
+			o.writeSeparator(&sb, word)
+			isWord, space = false, ""
+			continue
+		}
+
+		result, err := kanaToRomaji(withParticle(word, o), o)
+		if err != nil {
+			shiftError(err, str, start)
+
+			switch err := err.(type) {
+			case *ConversionError:
+				return "", err
+			case ConversionErrors:
+				convErrs = append(convErrs, err...)
+			}
+		}
+
+		if first, _ := utf8.DecodeRuneInString(word); !isClosingPunctuation(first) {
+			sb.WriteString(space)
+		}
+
+		isPunctuation := len(strings.TrimFunc(word, o.isPunctuation)) == 0
+		if isWord && !isPunctuation && len(result) != 0 {
+			sb.WriteByte(' ')
+		}
+
+		sb.WriteString(result)
+		isWord = !isPunctuation && len(result) != 0
+
+		space = ""
+		last, _ := utf8.DecodeLastRuneInString(word)
+		if punctuation, ok := o.punctuation[last]; ok && len(result) != 0 {
+			space = punctuation[len(strings.TrimRight(punctuation, " ")):]
+		}
+	}
+
+	o.writeSeparator(&sb, str[offset:])
+
+	if len(convErrs) != 0 {
+		return sb.String(), convErrs
+	}
+
+	return sb.String(), nil
+}
+
+//	withParticle replaces the particles with the kana of their pronunciation,
+//	a particle is a part of the word between punctuation (は、 -> わ、, 「を」 -> 「お」).
+func withParticle(word string, o *options) string {
+	var sb strings.Builder
+	sb.Grow(len(word))
+
+	for len(word) != 0 {
+		end := strings.IndexFunc(word, o.isPunctuation)
+		if end == -1 {
+			end = len(word)
+		}
+
+		part := word[:end]
+		if pronunciation, ok := particles[part]; ok {
+			part = pronunciation
+		}
+
+		sb.WriteString(part)
+		word = word[end:]
+
+		// the punctuation after the part is kept as it is
+		end = strings.IndexFunc(word, func(r rune) bool { return !o.isPunctuation(r) })
+		if end == -1 {
+			end = len(word)
+		}
+
+		sb.WriteString(word[:end])
+		word = word[end:]
+	}
+
+	return sb.String()
+}
+
+//	isPunctuation checks whether the rune is punctuation which does not belong to a word
+func (o *options) isPunctuation(r rune) bool {
+	if _, ok := o.punctuation[r]; ok {
+		return true
+	}
+
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+//	writeSeparator writes the whitespace between words as it is, unless it is in the punctuation mapping (e.g. U+3000).
+//	Text which is not whitespace is not written, it belongs to a word which the segmenter changed.
+//	It returns whether anything was written.
+func (o *options) writeSeparator(sb *strings.Builder, separator string) bool {
+	if len(separator) == 0 || len(strings.TrimSpace(separator)) != 0 {
+		return false
+	}
+
+	for _, r := range separator {
+		if punctuation, ok := o.punctuation[r]; ok {
+			sb.WriteString(punctuation)
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,122 @@
+package kanaconv
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParticles(t *testing.T) {
+	input := []inp{
+		{input: "わたし は がくせい です", want: "watashi wa gakusei desu"},
+		{input: "がっこう へ いきます", want: "gakkou e ikimasu"},
+		{input: "ほん を よみます", want: "hon o yomimasu"},
+		{input: "はな は はなです", want: "hana wa hanadesu"},
+		{input: "へや へ", want: "heya e"},
+		{input: "ハ ヘ ヲ", want: "ha he wo"},
+		{input: "  わたし　は  ", want: "  watashi\u3000wa  "},
+		{input: "わたし は\nがくせい", want: "watashi wa\ngakusei"},
+		{input: "", want: ""},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithParticles(nil))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestParticlesSegmenter(t *testing.T) {
+	segmenter := func(str string) []string {
+		return []string{str[:9], str[9:12], str[12:24], str[24:]}
+	}
+
+	got, err := KanaToRomaji("わたしはがくせいです", WithParticles(segmenter))
+	assert.Equal(t, "watashi wa gakusei desu", got)
+	assert.Nil(t, err)
+
+	got, err = KanaToRomaji("わたしはがくせいです")
+	assert.Equal(t, "watashihagakuseidesu", got)
+	assert.Nil(t, err)
+}
+
+func TestParticlesPunctuation(t *testing.T) {
+	input := []inp{
+		{input: "ぼく は、がくせい", want: "boku wa, gakusei"},
+		{input: "ぼく は、 がくせい です。", want: "boku wa, gakusei desu."},
+		{input: "「を」 と 「は」", want: "\"o\" to \"wa\""},
+		{input: "へ！", want: "e!"},
+		{input: "はな、", want: "hana,"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithParticles(nil), WithPunctuation(PunctuationASCII()))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestParticlesSegmenterPunctuation(t *testing.T) {
+	segmenter := func(str string) []string {
+		return []string{"わたし", "は", "がくせい", "です", "。"}
+	}
+
+	got, err := KanaToRomaji("わたしはがくせいです。", WithParticles(segmenter), WithPunctuation(PunctuationASCII()))
+	assert.Equal(t, "watashi wa gakusei desu.", got)
+	assert.Nil(t, err)
+
+	segmenter = func(str string) []string {
+		return []string{"「", "わたし", "は", "」", "\n", "です"}
+	}
+
+	got, err = KanaToRomaji("「わたしは」\nです", WithParticles(segmenter), WithPunctuation(PunctuationASCII()))
+	assert.Equal(t, "\"watashi wa\"\ndesu", got)
+	assert.Nil(t, err)
+
+	segmenter = func(str string) []string {
+		return []string{"はい", "。", "それ", "は", "、", "「", "ほん", "」", "。"}
+	}
+
+	got, err = KanaToRomaji("はい。それは、「ほん」。", WithParticles(segmenter), WithPunctuation(PunctuationASCII()))
+	assert.Equal(t, "hai. sore wa, \"hon\".", got)
+	assert.Nil(t, err)
+}
+
+func TestParticlesOptions(t *testing.T) {
+	got, err := KanaToRomaji("とうきょう へ", WithParticles(nil), WithPassport(false))
+	assert.Equal(t, "TOKYO E", got)
+	assert.Nil(t, err)
+
+	got, err = KanaToRomaji("ほん を", WithParticles(nil), WithScheme(NihonShiki))
+	assert.Equal(t, "hon o", got)
+	assert.Nil(t, err)
+}
+
+func TestParticlesErrorPosition(t *testing.T) {
+	_, err := KanaToRomaji("わたし は ゃ", WithParticles(nil))
+
+	var convErr *ConversionError
+	assert.ErrorAs(t, err, &convErr)
+	assert.Equal(t, ErrYouonFirst, convErr.Err)
+	assert.Equal(t, 14, convErr.Offset)
+	assert.Equal(t, 6, convErr.Index)
+
+	_, err = KanaToRomaji("ゃ は ゃ", WithParticles(nil), WithLenient(ReplaceWithNothing))
+
+	var convErrs ConversionErrors
+	assert.ErrorAs(t, err, &convErrs)
+	assert.Len(t, convErrs, 2)
+	assert.Equal(t, 0, convErrs[0].Index)
+	assert.Equal(t, 4, convErrs[1].Index)
+}
+
+func TestParticlesSegmenterChangedWords(t *testing.T) {
+	segmenter := func(str string) []string {
+		return strings.Split(strings.ToUpper(str), "|")
+	}
+
+	got, err := KanaToRomaji("a|は", WithParticles(segmenter), WithPassthrough())
+	assert.Equal(t, "A wa", got)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,58 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassport(t *testing.T) {
+	input := []inp{
+		{input: "さとう", want: "SATO"},
+		{input: "おおの", want: "ONO"},
+		{input: "いとう", want: "ITO"},
+		{input: "こうの", want: "KONO"},
+		{input: "おおた", want: "OTA"},
+		{input: "ゆうこ", want: "YUKO"},
+		{input: "ゆうすけ", want: "YUSUKE"},
+		{input: "りょうこ", want: "RYOKO"},
+		{input: "しょうへい", want: "SHOHEI"},
+		{input: "じゅんいちろう", want: "JUNICHIRO"},
+		{input: "けいこ", want: "KEIKO"},
+		{input: "なんば", want: "NAMBA"},
+		{input: "ほんま", want: "HOMMA"},
+		{input: "さんぺい", want: "SAMPEI"},
+		{input: "かんだ", want: "KANDA"},
+		{input: "しんいち", want: "SHINICHI"},
+		{input: "はっとり", want: "HATTORI"},
+		{input: "きっかわ", want: "KIKKAWA"},
+		{input: "はっちょう", want: "HATCHO"},
+		{input: "ちづる", want: "CHIZURU"},
+		{input: "たかはし", want: "TAKAHASHI"},
+		{input: "ゆーこ", want: "YUKO"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithPassport(false))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestPassportOH(t *testing.T) {
+	input := []inp{
+		{input: "さとう", want: "SATOH"},
+		{input: "おおの", want: "OHNO"},
+		{input: "いとう", want: "ITOH"},
+		{input: "こうの", want: "KOHNO"},
+		{input: "ゆうこ", want: "YUKO"},
+		{input: "おおひら", want: "OHHIRA"},
+		{input: "ほんま", want: "HOMMA"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithPassport(true))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
@@ -0,0 +1,64 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassthrough(t *testing.T) {
+	input := []inp{
+		{input: "東京タワーに行く!", want: "東京tawaani行ku!"},
+		{input: "これはペンです。", want: "korehapendesu。"},
+		{input: "Hello ワールド 123", want: "Hello waarudo 123"},
+		{input: "🍣すし🍣", want: "🍣sushi🍣"},
+		{input: "ちょっと待って", want: "chotto待tte"},
+		{input: "東京 ", want: "東京 "},
+		{input: "a", want: "a"},
+		{input: "", want: ""},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithPassthrough())
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestPassthroughEdges(t *testing.T) {
+	input := []inp{
+		{input: "きゃ漢", want: "kya漢"},
+		{input: "漢きゃ", want: "漢kya"},
+		{input: "ラー漢ラー", want: "raa漢raa"},
+		{input: "さん、ぽ", want: "san、po"},
+		{input: "ｶﾞｯｺｳ!", want: "gakkou!"},
+		{input: "ﾊｲ｡ｿｳ｣", want: "hai｡sou｣"},
+		{input: "あ\xe3AA", want: "a\xe3AA"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithPassthrough(), WithNSeparator("'"))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestPassthroughInvalid(t *testing.T) {
+	input := []inp{
+		{input: "漢ゃ", want: "yōon cannot be the first character in a kana block"},
+		{input: "a ー", want: "chōonpu cannot be the first character in a block"},
+		{input: "!っあ", want: "sokuon cannot precede a vowel"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithPassthrough())
+		assert.Empty(t, got)
+		assert.EqualError(t, err, v.want)
+	}
+}
+
+func TestPassthroughPassport(t *testing.T) {
+	got, err := KanaToRomaji("なんば Namba", WithPassthrough(), WithPassport(false))
+	assert.Equal(t, "NAMBA NAMBA", got)
+	assert.Nil(t, err)
+}
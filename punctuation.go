@@ -0,0 +1,50 @@
+package kanaconv
+
+import (
+	"strings"
+	"unicode"
+)
+
+//	Punctuation maps Japanese punctuation to the text which KanaToRomaji writes instead of it (。 -> ". ").
+//	The middle dot (・) is dropped unless the mapping contains it, map it to " " or "-" to separate the words.
+type Punctuation map[rune]string
+
+//	PunctuationASCII returns a mapping of Japanese punctuation to ASCII punctuation.
+//	Each call returns a new map which can be changed freely, e.g. to map ・ to " ".
+func PunctuationASCII() Punctuation {
+	return Punctuation{
+		'。': ". ",
+		'、': ", ",
+		'「': "\"",
+		'」': "\"",
+		'『': "'",
+		'』': "'",
+		'（': "(",
+		'）': ")",
+		'！': "!",
+		'？': "?",
+		'〜': "~",
+		'…': "...",
+		'　': " ",
+	}
+}
+
+//	write writes the punctuation, a trailing space is not written at the end of the text,
+//	before a space or before closing punctuation (「はい。」 -> "hai.").
+func (p Punctuation) write(sb *strings.Builder, punctuation string, next rune) {
+	if strings.HasSuffix(punctuation, " ") && (next == 0 || unicode.IsSpace(next) || isClosingPunctuation(next)) {
+		punctuation = strings.TrimRight(punctuation, " ")
+	}
+
+	sb.WriteString(punctuation)
+}
+
+//	isClosingPunctuation checks whether no space is written before the punctuation
+func isClosingPunctuation(r rune) bool {
+	switch r {
+	case '。', '、', '」', '』', '）', '！', '？', '…':
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,79 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPunctuationASCII(t *testing.T) {
+	input := []inp{
+		{input: "はい。", want: "hai."},
+		{input: "はい。いいえ。", want: "hai. iie."},
+		{input: "はい、いいえ", want: "hai, iie"},
+		{input: "「はい。」", want: "\"hai.\""},
+		{input: "『はい』", want: "'hai'"},
+		{input: "なに！？", want: "nani!?"},
+		{input: "ええ〜", want: "ee~"},
+		{input: "ええ…", want: "ee..."},
+		{input: "はい　いいえ", want: "hai iie"},
+		{input: "あっ。", want: "a."},
+		{input: "ラーメン・ライス", want: "raamenraisu"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithPunctuation(PunctuationASCII()))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestPunctuationMiddleDot(t *testing.T) {
+	input := []struct {
+		input string
+		dot   string
+		want  string
+	}{
+		{input: "ラーメン・ライス", dot: " ", want: "raamen raisu"},
+		{input: "ラーメン・ライス", dot: "-", want: "raamen-raisu"},
+		{input: "ラーメン・ライス", dot: "", want: "raamenraisu"},
+		{input: "カッ・ト", dot: "-", want: "ka-to"},
+	}
+
+	for _, v := range input {
+		punctuation := PunctuationASCII()
+		punctuation['・'] = v.dot
+
+		got, err := KanaToRomaji(v.input, WithPunctuation(punctuation))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestPunctuationNotMapped(t *testing.T) {
+	got, err := KanaToRomaji("はい。")
+	assert.Empty(t, got)
+	assert.ErrorIs(t, err, ErrNotKana)
+
+	got, err = KanaToRomaji("はい。", WithPunctuation(Punctuation{'、': ", "}))
+	assert.Empty(t, got)
+	assert.ErrorIs(t, err, ErrNotKana)
+
+	got, err = KanaToRomaji("はい・いいえ")
+	assert.Equal(t, "haiiie", got)
+	assert.Nil(t, err)
+}
+
+func TestPunctuationProfiles(t *testing.T) {
+	got, err := KanaToRomaji("はい。", WithPunctuation(PunctuationASCII()), WithPassport(false))
+	assert.Equal(t, "HAI.", got)
+	assert.Nil(t, err)
+
+	got, err = KanaToRomaji("イタㇰ。", WithPunctuation(PunctuationASCII()), WithAinu())
+	assert.Equal(t, "itak.", got)
+	assert.Nil(t, err)
+
+	got, err = KanaToRomaji("ん、", WithPunctuation(Punctuation{'、': "/"}))
+	assert.Equal(t, "n/", got)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,25 @@
+package kanaconv
+
+import "strings"
+
+//	Replacement is what a lenient conversion writes instead of a character which cannot be converted.
+type Replacement int8
+
+const (
+	//	ReplaceWithOriginal keeps the original character.
+	ReplaceWithOriginal Replacement = iota
+	//	ReplaceWithQuestionMark writes "?".
+	ReplaceWithQuestionMark
+	//	ReplaceWithNothing drops the character.
+	ReplaceWithNothing
+)
+
+//	write writes the replacement, original is the text of the character in the source string
+func (rp Replacement) write(sb *strings.Builder, original string) {
+	switch rp {
+	case ReplaceWithOriginal:
+		sb.WriteString(original)
+	case ReplaceWithQuestionMark:
+		sb.WriteByte('?')
+	}
+}
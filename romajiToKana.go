@@ -0,0 +1,298 @@
+package kanaconv
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+//	RomajiToHiragana converts romaji (Hepburn, Kunrei-shiki or Nihon-shiki) to hiragana.
+//	Doubled consonants are converted to sokuon (kitte -> きって, matcha -> まっちゃ),
+//	"n", "nn" and "n'" to ん, and long vowels with a macron or a circumflex to vowel pairs (tōkyō -> とうきょう).
+//	It returns the converted hiragana string and any error encountered, errors are of type *ConversionError.
+func RomajiToHiragana(str string, opts ...Option) (result string, err error) {
+	return romajiToKana(str, newOptions(opts), false)
+}
+
+//	RomajiToKatakana converts romaji to katakana.
+//	In addition to the rules of RomajiToHiragana loanword sounds are supported (ti -> ティ, fa -> ファ, va -> ヴァ, she -> シェ, tsa -> ツァ),
+//	therefore ti, di, tu, du, tyu and dyu are not read as Kunrei-shiki.
+//	Long vowels with a macron or a circumflex are converted to chōonpu (rāmen -> ラーメン), doubled vowels only with WithChouonpu.
+//	It returns the converted katakana string and any error encountered, errors are of type *ConversionError.
+func RomajiToKatakana(str string, opts ...Option) (result string, err error) {
+	return romajiToKana(str, newOptions(opts), true)
+}
+
+func romajiToKana(str string, o *options, isKatakana bool) (string, error) {
+	var sb strings.Builder
+	sb.Grow(len(str) * 3)
+
+	var convErrs ConversionErrors
+
+	for i := 0; i < len(str); {
+		char := lowerByte(str[i])
+
+		switch {
+		case char == '-':
+			sb.WriteRune('ー')
+			i++
+			continue
+		case char == 'n':
+			next := peekLower(str, i+1)
+			if next == '\'' {
+				sb.WriteRune('ん')
+				i += 2
+				continue
+			} else if next == 'n' {
+				// "nn" before a vowel is ん and the next syllable (konnichiha), otherwise it is a single ん
+				sb.WriteRune('ん')
+				if hasVowelOrY(str, i+2) {
+					i++
+				} else {
+					i += 2
+				}
+				continue
+			} else if !hasVowelOrY(str, i+1) {
+				sb.WriteRune('ん')
+				i++
+				continue
+			}
+		case char == 'm':
+			// traditional Hepburn writes ん before b, m and p as "m" (namba, homma)
+			if isLabial(peekLower(str, i+1)) {
+				sb.WriteRune('ん')
+				i++
+				continue
+			}
+		case char == 't' && peekLower(str, i+1) == 'c' && peekLower(str, i+2) == 'h':
+			sb.WriteRune('っ')
+			i++
+			continue
+		case isConsonant(char) && peekLower(str, i+1) == char:
+			sb.WriteRune('っ')
+			i++
+			continue
+		}
+
+		kana, size, longVowel := readSyllable(str, i, isKatakana)
+		if size == 0 {
+			r, rSize := utf8.DecodeRuneInString(str[i:])
+			convErr := newConversionError(ErrNotRomaji, kanaRune{r: r, offset: i, index: utf8.RuneCountInString(str[:i])})
+			if !o.isLenient {
+				return "", convErr
+			}
+
+			convErrs = append(convErrs, convErr)
+			o.replacement.write(&sb, str[i:i+rSize])
+			i += rSize
+			continue
+		}
+
+		sb.WriteString(kana)
+		i += size
+
+		if longVowel != 0 {
+			if isKatakana {
+				sb.WriteRune('ー')
+			} else {
+				sb.WriteRune(longVowelHiragana(longVowel))
+			}
+		} else if isKatakana && o.isChouonpu {
+			if vowel := lowerByte(str[i-1]); isVowel(vowel) && peekLower(str, i) == vowel {
+				sb.WriteRune('ー')
+				i++
+			}
+		}
+	}
+
+	result := sb.String()
+	if isKatakana {
+		result = HiraganaToKatakana(result)
+	}
+
+	if len(convErrs) != 0 {
+		return result, convErrs
+	}
+
+	return result, nil
+}
+
+//	readSyllable finds the longest romaji syllable at the position.
+//	It returns the kana, the byte length of the syllable and its vowel if the vowel has a long mark.
+func readSyllable(str string, i int, isKatakana bool) (kana string, size int, longVowel byte) {
+	const maxLength = 4
+
+	var key [maxLength]byte
+	var ends [maxLength]int
+	var longs [maxLength]byte
+
+	length := 0
+	for j := i; length < maxLength && j < len(str); {
+		if str[j] < utf8.RuneSelf {
+			key[length] = lowerByte(str[j])
+			j++
+		} else {
+			r, n := utf8.DecodeRuneInString(str[j:])
+			vowel, ok := longVowelBase(r)
+			if !ok {
+				break
+			}
+
+			key[length] = vowel
+			longs[length] = vowel
+			j += n
+		}
+
+		ends[length] = j - i
+		length++
+	}
+
+	for ; length > 0; length-- {
+		if isKatakana {
+			if kana, ok := romajiLoanwordSyllables[string(key[:length])]; ok {
+				return kana, ends[length-1], longs[length-1]
+			}
+		}
+
+		if kana, ok := romajiSyllables[string(key[:length])]; ok {
+			return kana, ends[length-1], longs[length-1]
+		}
+	}
+
+	return "", 0, 0
+}
+
+//	longVowelHiragana returns the hiragana which makes the vowel long (ō -> う).
+func longVowelHiragana(vowel byte) rune {
+	switch vowel {
+	case 'a':
+		return 'あ'
+	case 'i':
+		return 'い'
+	case 'e':
+		return 'え'
+	default:
+		return 'う'
+	}
+}
+
+func lowerByte(char byte) byte {
+	if char >= 'A' && char <= 'Z' {
+		return char + 'a' - 'A'
+	}
+
+	return char
+}
+
+func peekLower(str string, i int) byte {
+	if i >= len(str) {
+		return 0
+	}
+
+	return lowerByte(str[i])
+}
+
+//	hasVowelOrY checks whether a vowel (including long vowels) or "y" is at the position
+func hasVowelOrY(str string, i int) bool {
+	if i >= len(str) {
+		return false
+	} else if str[i] < utf8.RuneSelf {
+		return isVowelOrY(lowerByte(str[i]))
+	}
+
+	r, _ := utf8.DecodeRuneInString(str[i:])
+	_, ok := longVowelBase(r)
+	return ok
+}
+
+func isVowel(char byte) bool {
+	switch char {
+	case 'a', 'i', 'u', 'e', 'o':
+		return true
+	default:
+		return false
+	}
+}
+
+func isConsonant(char byte) bool {
+	if char < 'a' || char > 'z' {
+		return false
+	}
+
+	switch char {
+	case 'a', 'i', 'u', 'e', 'o', 'n':
+		return false
+	default:
+		return true
+	}
+}
+
+var romajiSyllables = map[string]string{
+	// basic
+	"a": "あ", "i": "い", "u": "う", "e": "え", "o": "お",
+	"vu": "ゔ", "va": "ゔぁ", "vi": "ゔぃ", "ve": "ゔぇ", "vo": "ゔぉ",
+	"ye": "いぇ", "wi": "ゐ", "we": "ゑ",
+	// small
+	"xa": "ぁ", "xi": "ぃ", "xu": "ぅ", "xe": "ぇ", "xo": "ぉ",
+	"la": "ぁ", "li": "ぃ", "lu": "ぅ", "le": "ぇ", "lo": "ぉ",
+	"xya": "ゃ", "xyu": "ゅ", "xyo": "ょ", "lya": "ゃ", "lyu": "ゅ", "lyo": "ょ",
+	"xtsu": "っ", "ltsu": "っ", "xtu": "っ", "ltu": "っ",
+	"xwa": "ゎ", "lwa": "ゎ", "xka": "ゕ", "lka": "ゕ", "xke": "ゖ", "lke": "ゖ",
+	// k
+	"ka": "か", "ki": "き", "ku": "く", "ke": "け", "ko": "こ",
+	"kya": "きゃ", "kyu": "きゅ", "kyo": "きょ", "kwa": "くゎ",
+	// g
+	"ga": "が", "gi": "ぎ", "gu": "ぐ", "ge": "げ", "go": "ご",
+	"gya": "ぎゃ", "gyu": "ぎゅ", "gyo": "ぎょ", "gwa": "ぐゎ",
+	// s
+	"sa": "さ", "shi": "し", "si": "し", "su": "す", "se": "せ", "so": "そ",
+	"sha": "しゃ", "shu": "しゅ", "sho": "しょ", "she": "しぇ",
+	"sya": "しゃ", "syu": "しゅ", "syo": "しょ",
+	// z
+	"za": "ざ", "ji": "じ", "zi": "じ", "zu": "ず", "ze": "ぜ", "zo": "ぞ",
+	"ja": "じゃ", "ju": "じゅ", "jo": "じょ", "je": "じぇ",
+	"jya": "じゃ", "jyu": "じゅ", "jyo": "じょ",
+	"zya": "じゃ", "zyu": "じゅ", "zyo": "じょ",
+	// t
+	"ta": "た", "chi": "ち", "ti": "ち", "tsu": "つ", "tu": "つ", "te": "て", "to": "と",
+	"cha": "ちゃ", "chu": "ちゅ", "cho": "ちょ", "che": "ちぇ",
+	"tya": "ちゃ", "tyu": "ちゅ", "tyo": "ちょ",
+	// d
+	"da": "だ", "di": "ぢ", "du": "づ", "de": "で", "do": "ど",
+	"dya": "ぢゃ", "dyu": "ぢゅ", "dyo": "ぢょ",
+	// n
+	"na": "な", "ni": "に", "nu": "ぬ", "ne": "ね", "no": "の",
+	"nya": "にゃ", "nyu": "にゅ", "nyo": "にょ",
+	// h
+	"ha": "は", "hi": "ひ", "fu": "ふ", "hu": "ふ", "he": "へ", "ho": "ほ",
+	"hya": "ひゃ", "hyu": "ひゅ", "hyo": "ひょ",
+	"fa": "ふぁ", "fi": "ふぃ", "fe": "ふぇ", "fo": "ふぉ",
+	// b
+	"ba": "ば", "bi": "び", "bu": "ぶ", "be": "べ", "bo": "ぼ",
+	"bya": "びゃ", "byu": "びゅ", "byo": "びょ",
+	// p
+	"pa": "ぱ", "pi": "ぴ", "pu": "ぷ", "pe": "ぺ", "po": "ぽ",
+	"pya": "ぴゃ", "pyu": "ぴゅ", "pyo": "ぴょ",
+	// m
+	"ma": "ま", "mi": "み", "mu": "む", "me": "め", "mo": "も",
+	"mya": "みゃ", "myu": "みゅ", "myo": "みょ",
+	// y
+	"ya": "や", "yu": "ゆ", "yo": "よ",
+	// r
+	"ra": "ら", "ri": "り", "ru": "る", "re": "れ", "ro": "ろ",
+	"rya": "りゃ", "ryu": "りゅ", "ryo": "りょ",
+	// w
+	"wa": "わ", "wo": "を",
+}
+
+//	romajiLoanwordSyllables are read instead of romajiSyllables in katakana
+var romajiLoanwordSyllables = map[string]string{
+	"ye": "いぇ", "wi": "うぃ", "we": "うぇ", "wo": "うぉ",
+	"she": "しぇ", "je": "じぇ", "che": "ちぇ",
+	"kwa": "くぁ", "kwi": "くぃ", "kwe": "くぇ", "kwo": "くぉ",
+	"gwa": "ぐぁ", "gwi": "ぐぃ", "gwe": "ぐぇ", "gwo": "ぐぉ",
+	"tsa": "つぁ", "tsi": "つぃ", "tse": "つぇ", "tso": "つぉ",
+	"ti": "てぃ", "tu": "とぅ", "tyu": "てゅ",
+	"di": "でぃ", "du": "どぅ", "dyu": "でゅ",
+	"fa": "ふぁ", "fi": "ふぃ", "fe": "ふぇ", "fo": "ふぉ", "fyu": "ふゅ",
+	"va": "ゔぁ", "vi": "ゔぃ", "ve": "ゔぇ", "vo": "ゔぉ", "vyu": "ゔゅ",
+}
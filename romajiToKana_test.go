@@ -0,0 +1,226 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRomajiToHiraganaBasic(t *testing.T) {
+	input := []inp{
+		{input: "aiueo", want: "あいうえお"},
+		{input: "kakikukeko", want: "かきくけこ"},
+		{input: "sashisuseso", want: "さしすせそ"},
+		{input: "tachitsuteto", want: "たちつてと"},
+		{input: "hahifuheho", want: "はひふへほ"},
+		{input: "hiragana", want: "ひらがな"},
+		{input: "KataKana", want: "かたかな"},
+	}
+
+	for _, v := range input {
+		got, err := RomajiToHiragana(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestRomajiToHiraganaKunrei(t *testing.T) {
+	input := []inp{
+		{input: "sasisuseso", want: "さしすせそ"},
+		{input: "tatituteto", want: "たちつてと"},
+		{input: "hahihuheho", want: "はひふへほ"},
+		{input: "zazizuzezo", want: "ざじずぜぞ"},
+		{input: "syasin", want: "しゃしん"},
+		{input: "tyotto", want: "ちょっと"},
+		{input: "zyuu", want: "じゅう"},
+		{input: "hanadi", want: "はなぢ"},
+		{input: "tuduku", want: "つづく"},
+	}
+
+	for _, v := range input {
+		got, err := RomajiToHiragana(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestRomajiToHiraganaSokuon(t *testing.T) {
+	input := []inp{
+		{input: "kitte", want: "きって"},
+		{input: "matcha", want: "まっちゃ"},
+		{input: "mattya", want: "まっちゃ"},
+		{input: "zasshi", want: "ざっし"},
+		{input: "kippu", want: "きっぷ"},
+		{input: "gakkou", want: "がっこう"},
+	}
+
+	for _, v := range input {
+		got, err := RomajiToHiragana(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestRomajiToHiraganaN(t *testing.T) {
+	input := []inp{
+		{input: "hon", want: "ほん"},
+		{input: "honn", want: "ほん"},
+		{input: "kin'en", want: "きんえん"},
+		{input: "kinen", want: "きねん"},
+		{input: "kan'ya", want: "かんや"},
+		{input: "kanya", want: "かにゃ"},
+		{input: "konnichiha", want: "こんにちは"},
+		{input: "sensei", want: "せんせい"},
+		{input: "namba", want: "なんば"},
+		{input: "shimbun", want: "しんぶん"},
+	}
+
+	for _, v := range input {
+		got, err := RomajiToHiragana(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestRomajiToHiraganaYouon(t *testing.T) {
+	input := []inp{
+		{input: "kyakyukyo", want: "きゃきゅきょ"},
+		{input: "shashusho", want: "しゃしゅしょ"},
+		{input: "chachucho", want: "ちゃちゅちょ"},
+		{input: "jajujo", want: "じゃじゅじょ"},
+		{input: "ryokou", want: "りょこう"},
+	}
+
+	for _, v := range input {
+		got, err := RomajiToHiragana(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestRomajiToHiraganaLongVowel(t *testing.T) {
+	input := []inp{
+		{input: "tōkyō", want: "とうきょう"},
+		{input: "okāsan", want: "おかあさん"},
+		{input: "kūki", want: "くうき"},
+		{input: "onēsan", want: "おねえさん"},
+		{input: "tôkyô", want: "とうきょう"},
+		{input: "TŌKYŌ", want: "とうきょう"},
+	}
+
+	for _, v := range input {
+		got, err := RomajiToHiragana(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestRomajiToHiraganaRoundTrip(t *testing.T) {
+	input := []string{"きんえん", "きねん", "かんや", "かにゃ", "まっちゃ", "しんぶん"}
+
+	for _, v := range input {
+		romaji, err := KanaToRomaji(v, WithNSeparator("'"))
+		assert.Nil(t, err)
+
+		got, err := RomajiToHiragana(romaji)
+		assert.Equal(t, v, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestRomajiToHiraganaInvalid(t *testing.T) {
+	const want = "there is not a valid romaji syllable"
+
+	for _, v := range []string{"q", "kya!", "日本", "k"} {
+		got, err := RomajiToHiragana(v)
+		assert.Empty(t, got)
+		assert.EqualError(t, err, want)
+	}
+}
+
+func TestRomajiToKatakana(t *testing.T) {
+	input := []inp{
+		{input: "katakana", want: "カタカナ"},
+		{input: "rāmen", want: "ラーメン"},
+		{input: "ra-men", want: "ラーメン"},
+		{input: "raamen", want: "ラアメン"},
+		{input: "kappa", want: "カッパ"},
+		{input: "matcha", want: "マッチャ"},
+		{input: "pan", want: "パン"},
+		{input: "vu", want: "ヴ"},
+	}
+
+	for _, v := range input {
+		got, err := RomajiToKatakana(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestRomajiToKatakanaLoanwords(t *testing.T) {
+	input := []inp{
+		{input: "ti", want: "ティ"},
+		{input: "di", want: "ディ"},
+		{input: "tu", want: "トゥ"},
+		{input: "du", want: "ドゥ"},
+		{input: "fa", want: "ファ"},
+		{input: "fi", want: "フィ"},
+		{input: "fe", want: "フェ"},
+		{input: "fo", want: "フォ"},
+		{input: "va", want: "ヴァ"},
+		{input: "vi", want: "ヴィ"},
+		{input: "ve", want: "ヴェ"},
+		{input: "vo", want: "ヴォ"},
+		{input: "wi", want: "ウィ"},
+		{input: "we", want: "ウェ"},
+		{input: "wo", want: "ウォ"},
+		{input: "she", want: "シェ"},
+		{input: "je", want: "ジェ"},
+		{input: "che", want: "チェ"},
+		{input: "tsa", want: "ツァ"},
+		{input: "ye", want: "イェ"},
+		{input: "fyu", want: "フュ"},
+		{input: "tyu", want: "テュ"},
+		{input: "dyu", want: "デュ"},
+		{input: "vyu", want: "ヴュ"},
+		{input: "pātī", want: "パーティー"},
+		{input: "disuko", want: "ディスコ"},
+		{input: "firumu", want: "フィルム"},
+	}
+
+	for _, v := range input {
+		got, err := RomajiToKatakana(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestRomajiToKatakanaChouonpu(t *testing.T) {
+	input := []inp{
+		{input: "raamen", want: "ラーメン"},
+		{input: "biiru", want: "ビール"},
+		{input: "suupaa", want: "スーパー"},
+		{input: "keeki", want: "ケーキ"},
+		{input: "koohii", want: "コーヒー"},
+		{input: "sauna", want: "サウナ"},
+	}
+
+	for _, v := range input {
+		got, err := RomajiToKatakana(v.input, WithChouonpu())
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestRomajiToKatakanaRoundTrip(t *testing.T) {
+	input := []string{"ティ", "ディ", "ファ", "ヴァ", "ウィ", "シェ", "ツァ", "ラーメン"}
+
+	for _, v := range input {
+		romaji, err := KanaToRomaji(v)
+		assert.Nil(t, err)
+
+		got, err := RomajiToKatakana(romaji, WithChouonpu())
+		assert.Equal(t, v, got)
+		assert.Nil(t, err)
+	}
+}
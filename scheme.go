@@ -0,0 +1,67 @@
+package kanaconv
+
+//	Scheme is a romanization system of kana.
+type Scheme int8
+
+const (
+	//	Hepburn writes kana as they are pronounced in English (shi, chi, tsu, fu, ji, sha). It is the default scheme.
+	Hepburn Scheme = iota
+	//	KunreiShiki writes kana by their row in the gojūon table (si, ti, tu, hu, zi, sya).
+	KunreiShiki
+	//	NihonShiki is the same as KunreiShiki, but it keeps ぢ, づ, ゐ, ゑ and を apart (di, du, wi, we, wo, dya).
+	NihonShiki
+	//	ISO3602 is the ISO 3602 romanization, which follows KunreiShiki.
+	ISO3602
+	//	ISO3602Strict is the strict variant of ISO 3602, which follows NihonShiki.
+	ISO3602Strict
+)
+
+//	syllable returns the spelling of the kana if it differs from Hepburn in the scheme.
+//	Sounds outside of the scheme's syllabary (e.g. ファ or ツァ) are always written in Hepburn.
+func (s Scheme) syllable(kana rune) (string, bool) {
+	if s == Hepburn {
+		return "", false
+	}
+
+	strict := s == NihonShiki || s == ISO3602Strict
+
+	switch kana {
+	case 'し', 'シ':
+		return "si", true
+	case 'じ', 'ジ':
+		return "zi", true
+	case 'ち', 'チ':
+		return "ti", true
+	case 'つ', 'ツ':
+		return "tu", true
+	case 'ぢ', 'ヂ':
+		if strict {
+			return "di", true
+		}
+		return "zi", true
+	case 'づ', 'ヅ':
+		if strict {
+			return "du", true
+		}
+		return "zu", true
+	case 'ふ', 'フ':
+		return "hu", true
+	case 'ゐ', 'ヰ':
+		if strict {
+			return "wi", true
+		}
+		return "i", true
+	case 'ゑ', 'ヱ':
+		if strict {
+			return "we", true
+		}
+		return "e", true
+	case 'を', 'ヲ':
+		if strict {
+			return "wo", true
+		}
+		return "o", true
+	}
+
+	return "", false
+}
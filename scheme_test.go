@@ -0,0 +1,70 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemeKunrei(t *testing.T) {
+	input := []inp{
+		{input: "さしすせそ", want: "sasisuseso"},
+		{input: "ざじずぜぞ", want: "zazizuzezo"},
+		{input: "たちつてと", want: "tatituteto"},
+		{input: "だぢづでど", want: "dazizudedo"},
+		{input: "はひふへほ", want: "hahihuheho"},
+		{input: "わゐゑを", want: "waieo"},
+		{input: "しゃしゅしょ", want: "syasyusyo"},
+		{input: "ちゃちゅちょ", want: "tyatyutyo"},
+		{input: "じゃじゅじょ", want: "zyazyuzyo"},
+		{input: "ぢゃぢゅぢょ", want: "zyazyuzyo"},
+		{input: "きゃりゅう", want: "kyaryuu"},
+		{input: "まっちゃ", want: "mattya"},
+		{input: "ッチ", want: "tti"},
+		{input: "ふぁみりー", want: "famirii"},
+		{input: "ツァ", want: "tsa"},
+		{input: "くゎし", want: "kwasi"},
+	}
+
+	for _, scheme := range []Scheme{KunreiShiki, ISO3602} {
+		for _, v := range input {
+			got, err := KanaToRomaji(v.input, WithScheme(scheme))
+			assert.Equal(t, v.want, got)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func TestSchemeNihon(t *testing.T) {
+	input := []inp{
+		{input: "さしすせそ", want: "sasisuseso"},
+		{input: "たちつてと", want: "tatituteto"},
+		{input: "だぢづでど", want: "dadidudedo"},
+		{input: "はひふへほ", want: "hahihuheho"},
+		{input: "わゐゑを", want: "wawiwewo"},
+		{input: "ちゃちゅちょ", want: "tyatyutyo"},
+		{input: "ぢゃぢゅぢょ", want: "dyadyudyo"},
+		{input: "はなぢ", want: "hanadi"},
+		{input: "つづく", want: "tuduku"},
+		{input: "っち", want: "tti"},
+		{input: "っぢ", want: "ddi"},
+	}
+
+	for _, scheme := range []Scheme{NihonShiki, ISO3602Strict} {
+		for _, v := range input {
+			got, err := KanaToRomaji(v.input, WithScheme(scheme))
+			assert.Equal(t, v.want, got)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func TestSchemeHepburnDefault(t *testing.T) {
+	const want = "shichitsufujimatcha"
+
+	for _, v := range [2]string{"しちつふじまっちゃ", "シチツフジマッチャ"} {
+		got, err := KanaToRomaji(v, WithScheme(Hepburn))
+		assert.Equal(t, want, got)
+		assert.Nil(t, err)
+	}
+}
@@ -0,0 +1,62 @@
+package kanaconv
+
+import "strings"
+
+//	DanglingSokuon is the way sokuon (っ) is written when it is not followed by a consonant,
+//	e.g. at the end of a kana block (あっ), before ー, ・, ん, small kana or another sokuon.
+type DanglingSokuon int8
+
+const (
+	//	DanglingSokuonDefault drops the sokuon, but doubles ん (っん -> nn). It is the default.
+	DanglingSokuonDefault DanglingSokuon = iota
+	//	DanglingSokuonDrop drops the sokuon (あっ -> a, っん -> n).
+	DanglingSokuonDrop
+	//	DanglingSokuonApostrophe writes the sokuon as a glottal stop (あっ -> a').
+	DanglingSokuonApostrophe
+	//	DanglingSokuonXtsu writes the sokuon as "xtsu" (あっ -> axtsu).
+	DanglingSokuonXtsu
+	//	DanglingSokuonLtu writes the sokuon as "ltu" (あっ -> altu).
+	DanglingSokuonLtu
+	//	DanglingSokuonError returns ErrDanglingSokuon.
+	DanglingSokuonError
+)
+
+//	isDanglingSokuon checks whether sokuon cannot be applied to the next character
+func (o *options) isDanglingSokuon(next rune) bool {
+	switch next {
+	case 'っ', 'ッ', '・',
+		'ゃ', 'ゅ', 'ょ', 'ぁ', 'ぃ', 'ぅ', 'ぇ', 'ぉ', 'ゎ',
+		'ャ', 'ュ', 'ョ', 'ァ', 'ィ', 'ゥ', 'ェ', 'ォ', 'ヮ':
+		return true
+	case 'ん', 'ン':
+		return o.danglingSokuon != DanglingSokuonDefault
+	case 'ー':
+		// chōonpu extends the syllable before the sokuon first
+		return false
+	default:
+		return !IsKana(next)
+	}
+}
+
+//	writeDanglingSokuon writes the sokuon which is not followed by a consonant.
+//	An error is returned only if the conversion is not lenient, otherwise it is collected.
+func (o *options) writeDanglingSokuon(sb *strings.Builder, convErrs *ConversionErrors, sokuon kanaRune) *ConversionError {
+	switch o.danglingSokuon {
+	case DanglingSokuonApostrophe:
+		sb.WriteByte('\'')
+	case DanglingSokuonXtsu:
+		sb.WriteString("xtsu")
+	case DanglingSokuonLtu:
+		sb.WriteString("ltu")
+	case DanglingSokuonError:
+		convErr := newConversionError(ErrDanglingSokuon, sokuon)
+		if !o.isLenient {
+			return convErr
+		}
+
+		*convErrs = append(*convErrs, convErr)
+		o.replacement.write(sb, sokuon.source)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDanglingSokuonDefault(t *testing.T) {
+	input := []inp{
+		{input: "あっ", want: "a"},
+		{input: "えっ", want: "e"},
+		{input: "っん", want: "nn"},
+		{input: "あっっか", want: "akka"},
+		{input: "あっ・か", want: "aka"},
+		{input: "あっー", want: "aa"},
+		{input: "あーっ", want: "aa"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestDanglingSokuonPolicies(t *testing.T) {
+	input := []struct {
+		input  string
+		policy DanglingSokuon
+		want   string
+	}{
+		{input: "あっ", policy: DanglingSokuonDrop, want: "a"},
+		{input: "っん", policy: DanglingSokuonDrop, want: "n"},
+		{input: "あっ", policy: DanglingSokuonApostrophe, want: "a'"},
+		{input: "えっ、なに", policy: DanglingSokuonApostrophe, want: "e'、nani"},
+		{input: "あっん", policy: DanglingSokuonApostrophe, want: "a'n"},
+		{input: "あっっか", policy: DanglingSokuonApostrophe, want: "a'kka"},
+		{input: "あっー", policy: DanglingSokuonApostrophe, want: "aa'"},
+		{input: "あっ・か", policy: DanglingSokuonApostrophe, want: "a'ka"},
+		{input: "あっ", policy: DanglingSokuonXtsu, want: "axtsu"},
+		{input: "あっ", policy: DanglingSokuonLtu, want: "altu"},
+		{input: "ッン", policy: DanglingSokuonLtu, want: "ltun"},
+		{input: "まっちゃ", policy: DanglingSokuonError, want: "matcha"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithDanglingSokuon(v.policy), WithPassthrough())
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestDanglingSokuonError(t *testing.T) {
+	for _, v := range []string{"あっ", "っん", "あっっか", "あっー", "あっ・か", "かっゃ"} {
+		got, err := KanaToRomaji(v, WithDanglingSokuon(DanglingSokuonError))
+		assert.Empty(t, got)
+		assert.ErrorIs(t, err, ErrDanglingSokuon)
+
+		var convErr *ConversionError
+		assert.ErrorAs(t, err, &convErr)
+		assert.Equal(t, 'っ', convErr.Rune)
+		assert.Equal(t, CodeDanglingSokuon, convErr.Code)
+	}
+}
+
+func TestDanglingSokuonLenient(t *testing.T) {
+	got, err := KanaToRomaji("あっ、えっ", WithDanglingSokuon(DanglingSokuonError), WithPassthrough(), WithLenient(ReplaceWithQuestionMark))
+	assert.Equal(t, "a?、e?", got)
+
+	var convErrs ConversionErrors
+	assert.ErrorAs(t, err, &convErrs)
+	assert.Len(t, convErrs, 2)
+}
+
+func TestDanglingSokuonSmallKana(t *testing.T) {
+	input := []struct {
+		input  string
+		policy DanglingSokuon
+		want   string
+	}{
+		{input: "かっゃ", policy: DanglingSokuonDefault, want: "kya"},
+		{input: "きっょ", policy: DanglingSokuonDefault, want: "kyo"},
+		{input: "かっゃ", policy: DanglingSokuonDrop, want: "kya"},
+		{input: "かっゃ", policy: DanglingSokuonApostrophe, want: "kya'"},
+		{input: "ふっぁ", policy: DanglingSokuonXtsu, want: "faxtsu"},
+		{input: "キッョウ", policy: DanglingSokuonLtu, want: "kyoltuu"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithDanglingSokuon(v.policy))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
@@ -2,31 +2,142 @@
 package kanaconv
 
 import (
-	"errors"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
-//	KanaToRomaji converts kana (hiragana or katakana) to romaji.
-//	It returns the converted romaji string and any error encountered.
-func KanaToRomaji(str string) (result string, err error) {
+//	numeralKanji are the kanji of numbers after which ヶ is read as a counter ("ka")
+const numeralKanji = "〇一二三四五六七八九十百千万億何数幾"
+
+//	KanaToRomaji converts kana (hiragana or katakana, including half-width katakana) to romaji.
+//	It returns the converted romaji string and any error encountered, errors are of type *ConversionError.
+//	The romanization scheme can be changed with WithScheme and the spelling of long vowels with WithLongVowel.
+//	Non-kana characters are not allowed unless WithPassthrough is passed.
+//	Iteration marks (ゝ, ゞ, ヽ, ヾ, 〻) repeat the preceding kana, ゞ and ヾ in its voiced form.
+//	Sokuon which is not followed by a consonant is dropped unless WithDanglingSokuon is passed.
+//	Japanese punctuation is not allowed (・ is dropped) unless WithPunctuation is passed.
+//	Ainu katakana (including the Katakana Phonetic Extensions, ㇰ-ㇿ) are transliterated with WithAinu.
+//	With WithParticles the particles は, へ and を are written as pronounced (wa, e, o) and the words are separated with spaces.
+//	The romaji is in lowercase unless WithCasing is passed.
+//	The built-in rules can be replaced with WithTable.
+//	With WithLenient the conversion does not stop at the first error, all errors are returned as ConversionErrors.
+func KanaToRomaji(str string, opts ...Option) (string, error) {
+	o := newOptions(opts)
+	if o.tableErr != nil {
+		return "", o.tableErr
+	}
+
+	var result string
+	var err error
+	if o.isParticleAware {
+		result, err = particlesToRomaji(str, o)
+	} else {
+		result, err = kanaToRomaji(str, o)
+	}
+
+	return o.casing.apply(result, o.nSeparator), err
+}
+
+//	kanaToRomaji converts kana to romaji with the options, see KanaToRomaji.
+//	With CasingWapuro the romaji of katakana is written in uppercase.
+func kanaToRomaji(str string, o *options) (result string, err error) {
 	const byteCount = 3
+
 	if len(str) == 0 {
 		return "", nil
-	} else if len(str)%byteCount != 0 {
+	} else if !o.isPassthrough && !o.isLenient && len(str)%byteCount != 0 {
 		// kana is a 3-bit unicode char
-		return "", errors.New("all characters must be kana (3-bit unicode characters)")
+		return "", newConversionError(ErrInvalidLength, findInvalidLength(str))
+	}
+
+	if o.isAinu {
+		return ainuToRomaji(str, o)
 	}
 
 	var sb strings.Builder
 	sb.Grow(len(str) * 2)
 
-	var rPrev string
-	var isSokuon bool
-	for i := 0; i < len(str); i += byteCount {
-		var rStr string
+	// rPrev is the pending syllable in the selected scheme, hPrev is the same syllable in Hepburn
+	var rPrev, hPrev string
+	// kPrev is set when the pending syllable is katakana, isN is set when it is ん
+	var isSokuon, isLong, kPrev, isN bool
+	var sokuon kanaRune
+	// spans are the romaji of katakana, see CasingWapuro
+	var spans katakanaSpans
+	// rKana is the last kana which an iteration mark repeats
+	var rKana rune
+	var convErrs ConversionErrors
+	// skip is the number of runes which the last table entry matched after its first rune
+	var skip int
+	runes := decodeKana(str)
+	for i, kana := range runes {
+		var rStr, rHepburn string
 		var rYouon youon
+		var convErr *ConversionError
+		var tableSize int
+		// start is the length of the romaji before the last write
+		var start int
+
+		if skip != 0 {
+			skip--
+			continue
+		}
+
+		r := kana.r
+		if isIterationMark(r) {
+			if rKana != 0 {
+				r = iterate(rKana, r)
+			} else if r != '〻' || !o.isPassthrough {
+				convErr = newConversionError(ErrIterationMarkFirst, kana)
+				goto Failure
+			}
+		}
+
+		rKana = iterationBase(rKana, r)
+
+		// a small kana combines with the syllable before the sokuon first (かっゃ -> kya')
+		if isSokuon && o.isDanglingSokuon(r) && !isYouon(toKatakana(r)) {
+			isSokuon = false
+			start = sb.Len()
+			o.writeSyllable(&sb, rPrev, isLong)
+			spans.add(&sb, start, kPrev)
+			rPrev, hPrev, isLong = "", "", false
+
+			start = sb.Len()
+			if convErr = o.writeDanglingSokuon(&sb, &convErrs, sokuon); convErr != nil {
+				return "", convErr
+			}
+			spans.add(&sb, start, IsKatakana(sokuon.r))
+		}
+
+		if o.table != nil && !o.isCounter(runes, i, r) {
+			if rStr, tableSize = o.table.match(runes, i, r); tableSize != 0 {
+				skip = tableSize - 1
+				for _, next := range runes[i+1 : i+tableSize] {
+					rKana = iterationBase(rKana, next.r)
+				}
+				if len(rStr) == 0 {
+					continue
+				}
+
+				goto RomajiString
+			}
+		}
 
-		switch getKanaRune(str[i], str[i+1], str[i+2]) {
+		if punctuation, ok := o.punctuation[r]; ok {
+			// the kana block ends, the punctuation is written instead
+			start = sb.Len()
+			o.writeSyllable(&sb, rPrev, isLong)
+			spans.add(&sb, start, kPrev)
+			o.punctuation.write(&sb, punctuation, nextRune(runes, i))
+
+			rPrev, hPrev, rKana = "", "", 0
+			isLong = false
+			continue
+		}
+
+		switch r {
 		// basic
 		case 'あ', 'ア':
 			rStr = "a"
@@ -265,9 +376,35 @@ func KanaToRomaji(str string) (result string, err error) {
 		case 'を', 'ヲ':
 			rStr = "wo"
 			goto RomajiString
+		// w dakuten (v)
+		case 'ヷ':
+			rStr = "va"
+			goto RomajiString
+		case 'ヸ':
+			rStr = "vi"
+			goto RomajiString
+		case 'ヹ':
+			rStr = "ve"
+			goto RomajiString
+		case 'ヺ':
+			rStr = "vo"
+			goto RomajiString
+		// small k (counters)
+		case 'ゕ', 'ヵ':
+			rStr = "ka"
+			if reading, ok := counterReading(runes, i); ok && o.readsCounterKana {
+				rStr = reading
+			}
+			goto RomajiString
+		case 'ゖ', 'ヶ':
+			rStr = "ke"
+			if reading, ok := counterReading(runes, i); ok && o.readsCounterKana {
+				rStr = reading
+			}
+			goto RomajiString
 		// sokuon
 		case 'っ', 'ッ':
-			isSokuon = true
+			isSokuon, sokuon = true, kana
 			continue
 		// youon
 		case 'ゃ', 'ャ':
@@ -303,52 +440,114 @@ func KanaToRomaji(str string) (result string, err error) {
 		case '・':
 			continue
 		default:
-			return "", errors.New("there is not a valid kana character")
+			if !o.isPassthrough {
+				convErr = newConversionError(ErrNotKana, kana)
+				goto Failure
+			}
+
+			// the kana block ends, the character is copied as it is
+			start = sb.Len()
+			o.writeSyllable(&sb, rPrev, isLong)
+			spans.add(&sb, start, kPrev)
+			sb.WriteString(kana.source)
+
+			rPrev, hPrev, rKana = "", "", 0
+			isSokuon, isLong = false, false
+			continue
 		}
 
 	RomajiString:
-		if len(rPrev) != 0 {
-			sb.WriteString(rPrev)
+		if o.longVowel != LongVowelDoubled && !isLong && !isSokuon && tableSize <= 1 && len(rPrev) != 0 && isLongVowelPair(rPrev[len(rPrev)-1], r) {
+			isLong = true
+			continue
+		}
+
+		rHepburn = rStr
+		if s, ok := o.scheme.syllable(r); ok && tableSize == 0 {
+			rStr = s
 		}
 
+		start = sb.Len()
+		if isN {
+			o.writeN(&sb, rPrev, rStr)
+		} else {
+			o.writeSyllable(&sb, rPrev, isLong)
+		}
+		spans.add(&sb, start, kPrev)
+
 		if isSokuon {
 			isSokuon = false
 
+			start = sb.Len()
 			switch rStr[0] {
 			case 'c':
 				sb.WriteByte('t')
 			case 'a', 'i', 'u', 'e', 'o':
-				return "", errors.New("sokuon cannot precede a vowel")
+				// the sokuon is the offending character
+				convErr = newConversionError(ErrSokuonBeforeVowel, sokuon)
+				if !o.isLenient {
+					return "", convErr
+				}
+
+				// the sokuon is replaced, the vowel is converted as usual
+				convErrs = append(convErrs, convErr)
+				o.replacement.write(&sb, sokuon.source)
 			default:
-				sb.WriteByte(rStr[0])
+				// the value of a custom table can start with a letter which is not ASCII (ķa)
+				r, _ := utf8.DecodeRuneInString(rStr)
+				sb.WriteRune(r)
 			}
+			spans.add(&sb, start, IsKatakana(sokuon.r))
 		}
 
-		rPrev = rStr
+		rPrev, hPrev, kPrev = rStr, rHepburn, IsKatakana(r)
+		// ん is found by its kana, the table can change its romaji (ん -> N)
+		isN = (r == 'ん' || r == 'ン') && tableSize <= 1
+		isLong = false
 		continue
 	Youon:
 		if len(rPrev) == 0 {
-			return "", errors.New("yōon cannot be the first character in a kana block")
+			convErr = newConversionError(ErrYouonFirst, kana)
+			goto Failure
 		}
 
 		{
 			yChar := rYouon.char()
-			rPrev = rPrev[0 : len(rPrev)-1]
+			stem := rPrev[0 : len(rPrev)-1]
+			if len(stem) != 0 && rPrev[len(rPrev)-1] != 'i' {
+				// foreign sounds (フュ, テュ) are spelled in Hepburn regardless of the scheme
+				stem = hPrev[0 : len(hPrev)-1]
+			}
 
-			switch rPrev[0] {
-			case 'k', 'g', 't', 'd', 'n', 'h', 'f', 'b', 'p', 'm', 'r', 'v':
-				rPrev += "y" + yChar
-			case 's', 'j', 'c':
-				rPrev += yChar
+			if len(stem) == 0 {
+				// a vowel cannot be combined
+				convErr = newConversionError(ErrYouonCombination, kana)
+				goto Failure
+			}
+
+			switch stem[0] {
+			case 'k', 'g', 'z', 't', 'd', 'n', 'h', 'f', 'b', 'p', 'm', 'r', 'v':
+				rPrev = stem + "y" + yChar
+			case 's':
+				// Hepburn "sh" takes the vowel as it is, Kunrei-shiki "s" takes "y" first
+				if len(stem) == 1 {
+					stem += "y"
+				}
+				rPrev = stem + yChar
+			case 'j', 'c':
+				rPrev = stem + yChar
 			default:
-				return "", errors.New("unrecognised yōon combination")
+				convErr = newConversionError(ErrYouonCombination, kana)
+				goto Failure
 			}
 
-			continue
+			hPrev = rPrev
+			goto DanglingSokuon
 		}
 	YouonSpecial:
 		if len(rPrev) == 0 {
-			return "", errors.New("yōon cannot be the first character in a kana block")
+			convErr = newConversionError(ErrYouonFirst, kana)
+			goto Failure
 		}
 
 		{
@@ -361,46 +560,214 @@ func KanaToRomaji(str string) (result string, err error) {
 				case 'u':
 					rPrev = "w"
 				default:
-					return "", errors.New("unrecognised yōon vowel")
+					convErr = newConversionError(ErrYouonVowel, kana)
+					goto Failure
 				}
 
 				rPrev += yChar
+				hPrev = rPrev
 			} else {
 				switch rPrev[len(rPrev)-1] {
 				case 'a', 'u', 'e', 'o':
-					rPrev = rPrev[:len(rPrev)-1] + yChar
+					// foreign sounds are spelled in Hepburn regardless of the scheme
+					stem := hPrev[:len(hPrev)-1]
+					if (hPrev == "ku" || hPrev == "gu") && rYouon != youonU && rYouon != youonWa {
+						// クァ, グァ, etc. are spelled with "w" like クヮ
+						stem += "w"
+					}
+
+					rPrev = stem + yChar
 				case 'i':
+					// foreign sounds (シェ, チェ) are spelled in Hepburn regardless of the scheme
+					rPrev = hPrev
 					goto Youon
 				default:
-					return "", errors.New("unrecognised yōon syllable")
+					convErr = newConversionError(ErrYouonSyllable, kana)
+					goto Failure
 				}
+
+				hPrev = rPrev
 			}
 
-			continue
+			goto DanglingSokuon
 		}
 	Chouonpu:
 		if len(rPrev) == 0 {
-			return "", errors.New("chōonpu cannot be the first character in a block")
+			convErr = newConversionError(ErrChouonpuFirst, kana)
+			goto Failure
 		}
 
 		{
 			rChouonpu := rPrev[len(rPrev)-1]
 			switch rChouonpu {
 			case 'a', 'i', 'u', 'e', 'o':
-				rPrev += string(rChouonpu)
+				if o.longVowel != LongVowelDoubled {
+					isLong = true
+				} else {
+					rPrev += string(rChouonpu)
+					hPrev = rPrev
+				}
 			default:
-				return "", errors.New("chōonpu cannot extend a consonant")
+				convErr = newConversionError(ErrChouonpuConsonant, kana)
+				goto Failure
 			}
+		}
+	DanglingSokuon:
+		// the small kana or chōonpu extended the pending syllable
+		isN = false
 
-			continue
+		if isSokuon {
+			// sokuon before chōonpu or a small kana follows the syllable which they extend (あっー -> aa', かっゃ -> kya')
+			isSokuon = false
+			start = sb.Len()
+			o.writeSyllable(&sb, rPrev, isLong)
+			spans.add(&sb, start, kPrev)
+			rPrev, hPrev, isLong = "", "", false
+
+			start = sb.Len()
+			if convErr = o.writeDanglingSokuon(&sb, &convErrs, sokuon); convErr != nil {
+				return "", convErr
+			}
+			spans.add(&sb, start, IsKatakana(sokuon.r))
+		}
+
+		continue
+	Failure:
+		if !o.isLenient {
+			return "", convErr
+		}
+
+		// the kana block ends, the offending character is replaced
+		convErrs = append(convErrs, convErr)
+		start = sb.Len()
+		o.writeSyllable(&sb, rPrev, isLong)
+		spans.add(&sb, start, kPrev)
+		o.replacement.write(&sb, kana.source)
+
+		rPrev, hPrev, rKana = "", "", 0
+		isSokuon, isLong = false, false
+	}
+
+	start := sb.Len()
+	o.writeSyllable(&sb, rPrev, isLong)
+	spans.add(&sb, start, kPrev)
+
+	if isSokuon {
+		start = sb.Len()
+		if convErr := o.writeDanglingSokuon(&sb, &convErrs, sokuon); convErr != nil {
+			return "", convErr
+		}
+		spans.add(&sb, start, IsKatakana(sokuon.r))
+	}
+
+	result = sb.String()
+	if o.casing == CasingWapuro {
+		result = spans.upper(result)
+	}
+
+	if len(convErrs) != 0 {
+		return result, convErrs
+	}
+
+	return result, nil
+}
+
+//	findInvalidLength finds the first character which is not a 3-bit unicode character
+func findInvalidLength(str string) kanaRune {
+	var index int
+	for i, r := range str {
+		if utf8.RuneLen(r) != 3 {
+			return kanaRune{r: r, offset: i, index: index}
 		}
+		index++
+	}
+
+	return kanaRune{r: utf8.RuneError, offset: len(str), index: index}
+}
+
+//	nextRune returns the rune after the index or 0 at the end of the string
+func nextRune(runes []kanaRune, i int) rune {
+	if i+1 < len(runes) {
+		return runes[i+1].r
+	}
+
+	return 0
+}
+
+//	counterReading returns the reading of the small ヶ (or ヵ) between a number or a kanji and a kanji:
+//	"ka" after a number (一ヶ月, 3ヶ所, 何ヶ国), "ga" after another kanji (霞ヶ関).
+//	False is returned if the small kana is not between them.
+func counterReading(runes []kanaRune, i int) (string, bool) {
+	if i == 0 || i+1 == len(runes) {
+		return "", false
+	}
+
+	prev, next := runes[i-1].r, runes[i+1].r
+	switch {
+	case !unicode.Is(unicode.Han, next):
+		return "", false
+	case unicode.IsDigit(prev) || strings.ContainsRune(numeralKanji, prev):
+		return "ka", true
+	case unicode.Is(unicode.Han, prev):
+		return "ga", true
+	default:
+		return "", false
+	}
+}
+
+//	isCounter checks whether the small kana at the index is read as a counter (see WithCounterKana), it is not looked up in the table
+func (o *options) isCounter(runes []kanaRune, i int, r rune) bool {
+	switch r {
+	case 'ゕ', 'ゖ', 'ヵ', 'ヶ':
+		_, ok := counterReading(runes, i)
+		return ok && o.readsCounterKana
+	default:
+		return false
+	}
+}
+
+//	writeSyllable writes the pending syllable
+func (o *options) writeSyllable(sb *strings.Builder, syllable string, isLong bool) {
+	if len(syllable) == 0 {
+		return
+	}
+
+	if isLong {
+		sb.WriteString(o.longVowel.extend(syllable))
+	} else {
+		sb.WriteString(syllable)
+	}
+}
+
+//	writeN writes the pending ん, next is the syllable which follows it in the kana block
+func (o *options) writeN(sb *strings.Builder, syllable string, next string) {
+	if len(syllable) == 0 {
+		return
 	}
 
-	if len(rPrev) != 0 {
-		sb.WriteString(rPrev)
+	if o.labialN && isLabial(next[0]) {
+		sb.WriteByte('m')
+	} else if len(o.nSeparator) != 0 && isVowelOrY(next[0]) {
+		sb.WriteString(syllable)
+		sb.WriteString(o.nSeparator)
+	} else {
+		sb.WriteString(syllable)
 	}
+}
+
+//	isVowelOrY checks whether ん needs to be separated from the following letter
+func isVowelOrY(char byte) bool {
+	switch char {
+	case 'a', 'i', 'u', 'e', 'o', 'y':
+		return true
+	default:
+		return false
+	}
+}
 
-	return sb.String(), nil
+//	isLabial checks whether ん is pronounced as "m" before the consonant
+func isLabial(consonant byte) bool {
+	return consonant == 'b' || consonant == 'm' || consonant == 'p'
 }
 
 //	getKanaRune converts a 3-bit hex value to its unicode code point
@@ -0,0 +1,165 @@
+package kanaconv
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+//	Table maps kana sequences to romaji, KanaToRomaji uses it instead of the built-in rules for the kana it contains (see WithTable).
+//	The longest sequence which matches the text is used ("ちゃ" before "ち"), the romaji is written regardless of the scheme.
+//	Sokuon, chōonpu, long vowels and ん are handled for the table romaji the same way as for the built-in rules.
+//	ヵ and ヶ which WithCounterKana reads as counters (一ヶ月) are not looked up in the table.
+type Table map[string]string
+
+//	TableError describes an entry of a Table which is rejected by Validate.
+type TableError struct {
+	//	Kana is the key of the rejected entry
+	Kana string
+	//	Other is the key of the entry which Kana conflicts with (if any)
+	Other string
+	//	Err is ErrTableUnreachable or ErrTableConflict
+	Err error
+}
+
+func (e *TableError) Error() string {
+	if len(e.Other) != 0 {
+		return fmt.Sprintf("%s: %q and %q", e.Err.Error(), e.Kana, e.Other)
+	}
+
+	return fmt.Sprintf("%s: %q", e.Err.Error(), e.Kana)
+}
+
+func (e *TableError) Unwrap() error {
+	return e.Err
+}
+
+//	DefaultTable returns the built-in Hepburn rules of KanaToRomaji as a table:
+//	every kana on its own (か -> ka) and every kana with a small kana (きゃ -> kya, ファ -> fa).
+//	Each call returns a new table which can be changed freely.
+func DefaultTable() Table {
+	o := newOptions(nil)
+	table := make(Table)
+
+	var kana []rune
+	for r := 'ぁ'; r <= 'ゖ'; r++ {
+		kana = append(kana, r, toKatakana(r))
+	}
+	kana = append(kana, 'ヷ', 'ヸ', 'ヹ', 'ヺ')
+
+	for _, r := range kana {
+		if romaji, err := kanaToRomaji(string(r), o); err == nil && len(romaji) != 0 {
+			table[string(r)] = romaji
+		}
+	}
+
+	for _, r := range kana {
+		if _, ok := table[string(r)]; !ok {
+			continue
+		}
+
+		for _, small := range "ゃゅょぁぃぅぇぉゎ" {
+			if IsKatakana(r) {
+				small = toKatakana(small)
+			}
+
+			seq := string([]rune{r, small})
+			if romaji, err := kanaToRomaji(seq, o); err == nil {
+				table[seq] = romaji
+			}
+		}
+	}
+
+	return table
+}
+
+//	Clone returns a copy of the table which can be changed without changing the original.
+func (t Table) Clone() Table {
+	clone := make(Table, len(t))
+	for kana, romaji := range t {
+		clone[kana] = romaji
+	}
+
+	return clone
+}
+
+//	Validate checks whether every entry of the table can be used, the error is of type *TableError.
+//	An entry is unreachable if its kana is empty or contains an iteration mark (which is expanded before the lookup).
+//	Entries conflict if their kana are the same after normalization (か゛ and が, ｶ and カ) but their romaji differ.
+func (t Table) Validate() error {
+	_, err := t.index()
+	return err
+}
+
+//	tableIndex is a table with the kana normalized the way the converter decodes the text
+type tableIndex struct {
+	entries map[string]string
+	// original are the keys of the entries in the table
+	original  map[string]string
+	maxLength int
+}
+
+func (t Table) index() (*tableIndex, error) {
+	keys := make([]string, 0, len(t))
+	for kana := range t {
+		keys = append(keys, kana)
+	}
+	// errors are reported in the same order every time
+	sort.Strings(keys)
+
+	index := &tableIndex{
+		entries:  make(map[string]string, len(t)),
+		original: make(map[string]string, len(t)),
+	}
+
+	for _, kana := range keys {
+		runes := decodeKana(kana)
+		if len(runes) == 0 {
+			return nil, &TableError{Kana: kana, Err: ErrTableUnreachable}
+		}
+
+		var sb strings.Builder
+		for _, r := range runes {
+			if isIterationMark(r.r) {
+				return nil, &TableError{Kana: kana, Err: ErrTableUnreachable}
+			}
+
+			sb.WriteRune(r.r)
+		}
+
+		key := sb.String()
+		if romaji, ok := index.entries[key]; ok && romaji != t[kana] {
+			return nil, &TableError{Kana: kana, Other: index.original[key], Err: ErrTableConflict}
+		}
+
+		index.entries[key] = t[kana]
+		index.original[key] = kana
+		if len(runes) > index.maxLength {
+			index.maxLength = len(runes)
+		}
+	}
+
+	return index, nil
+}
+
+//	match finds the longest entry which starts at the index, r is the rune at the index with the iteration mark expanded.
+//	The romaji and the number of matched runes are returned, the number is 0 if nothing matches.
+func (ti *tableIndex) match(runes []kanaRune, i int, r rune) (string, int) {
+	var romaji string
+	var size int
+
+	key := string(r)
+	for n := 1; ; n++ {
+		if value, ok := ti.entries[key]; ok {
+			romaji, size = value, n
+		}
+
+		if n == ti.maxLength || i+n == len(runes) {
+			break
+		}
+
+		key += string(runes[i+n].r)
+	}
+
+	return romaji, size
+}
@@ -0,0 +1,162 @@
+package kanaconv
+
+import (
+	"errors"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultTable(t *testing.T) {
+	table := DefaultTable()
+	assert.Equal(t, "ka", table["か"])
+	assert.Equal(t, "ka", table["カ"])
+	assert.Equal(t, "tsu", table["つ"])
+	assert.Equal(t, "kya", table["きゃ"])
+	assert.Equal(t, "fa", table["ファ"])
+	assert.Equal(t, "va", table["ヷ"])
+	assert.NotContains(t, table, "っ")
+	assert.NotContains(t, table, "ゃ")
+	assert.NotContains(t, table, "ー")
+	assert.Nil(t, table.Validate())
+}
+
+func TestDefaultTableSameAsBuiltIn(t *testing.T) {
+	input := []string{"とうきょう", "ちょっと", "こんにちは", "しんいち", "ラーメン", "ファッション", "まっちゃ", "あっ", "いすゞ", "ヴァイオリン"}
+	options := [][]Option{
+		nil,
+		{WithLongVowel(LongVowelMacron)},
+		{WithNSeparator("'")},
+		{WithPassport(false)},
+	}
+
+	table := DefaultTable()
+	for _, v := range input {
+		for _, opts := range options {
+			want, wantErr := KanaToRomaji(v, opts...)
+			got, err := KanaToRomaji(v, append(opts, WithTable(table))...)
+			assert.Equal(t, want, got, v)
+			assert.Equal(t, wantErr, err, v)
+		}
+	}
+}
+
+func TestTableOverride(t *testing.T) {
+	table := DefaultTable()
+	table["つ"], table["ツ"] = "tu", "tu"
+	table["づ"] = "dzu"
+	table["シュヴァ"] = "schwa"
+
+	input := []inp{
+		{input: "つなみ", want: "tunami"},
+		{input: "ツナミ", want: "tunami"},
+		{input: "みかづき", want: "mikadzuki"},
+		{input: "シュヴァルツ", want: "schwarutu"},
+		{input: "ッシュヴァ", want: "sschwa"},
+		{input: "シュヴ", want: "shuvu"},
+		{input: "ちょっと", want: "chotto"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithTable(table))
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+
+	got, err := KanaToRomaji("つなみ")
+	assert.Equal(t, "tsunami", got)
+	assert.Nil(t, err)
+}
+
+func TestTableCounterKana(t *testing.T) {
+	input := []inp{
+		{input: "一ヶ月", want: "一ka月"},
+		{input: "霞ヶ関", want: "霞ga関"},
+		{input: "ヶ", want: "ke"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input, WithTable(DefaultTable()), WithPassthrough(), WithCounterKana())
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestTableN(t *testing.T) {
+	table := Table{"ん": "N"}
+
+	got, err := KanaToRomaji("きんえん", WithTable(table), WithNSeparator("'"))
+	assert.Equal(t, "kiN'eN", got)
+	assert.Nil(t, err)
+
+	got, err = KanaToRomaji("さんぽ", WithTable(table), WithPassport(false))
+	assert.Equal(t, "SAMPO", got)
+	assert.Nil(t, err)
+
+	got, err = KanaToRomaji("さんぽ", WithTable(table))
+	assert.Equal(t, "saNpo", got)
+	assert.Nil(t, err)
+}
+
+func TestTableSokuonNotASCII(t *testing.T) {
+	got, err := KanaToRomaji("っか", WithTable(Table{"か": "ķa"}))
+	assert.Equal(t, "ķķa", got)
+	assert.True(t, utf8.ValidString(got))
+	assert.Nil(t, err)
+}
+
+func TestTableRules(t *testing.T) {
+	table := Table{"し": "shi", "ぬ": ""}
+
+	got, err := KanaToRomaji("しか", WithScheme(KunreiShiki), WithTable(table))
+	assert.Equal(t, "shika", got)
+	assert.Nil(t, err)
+
+	got, err = KanaToRomaji("しーぬし", WithTable(table), WithLongVowel(LongVowelMacron))
+	assert.Equal(t, "shīshi", got)
+	assert.Nil(t, err)
+
+	got, err = KanaToRomaji("しゃ", WithTable(table))
+	assert.Equal(t, "sha", got)
+	assert.Nil(t, err)
+}
+
+func TestTableClone(t *testing.T) {
+	table := Table{"つ": "tsu"}
+	clone := table.Clone()
+	clone["つ"] = "tu"
+
+	assert.Equal(t, "tsu", table["つ"])
+	assert.Equal(t, "tu", clone["つ"])
+}
+
+func TestTableValidate(t *testing.T) {
+	input := []struct {
+		table Table
+		err   error
+		kana  string
+		other string
+	}{
+		{table: Table{"": "x"}, err: ErrTableUnreachable, kana: ""},
+		{table: Table{"こゝ": "koko"}, err: ErrTableUnreachable, kana: "こゝ"},
+		{table: Table{"が": "ga", "か゛": "ka"}, err: ErrTableConflict, kana: "が", other: "か゛"},
+		{table: Table{"カ": "ka", "ｶ": "ga"}, err: ErrTableConflict, kana: "ｶ", other: "カ"},
+	}
+
+	for _, v := range input {
+		err := v.table.Validate()
+		assert.ErrorIs(t, err, v.err)
+
+		var tableErr *TableError
+		assert.True(t, errors.As(err, &tableErr))
+		assert.Equal(t, v.kana, tableErr.Kana)
+		assert.Equal(t, v.other, tableErr.Other)
+
+		got, err := KanaToRomaji("か", WithTable(v.table))
+		assert.Empty(t, got)
+		assert.ErrorIs(t, err, v.err)
+	}
+
+	assert.Nil(t, Table{"カ": "ka", "ｶ": "ka", "か゛": "ga"}.Validate())
+}
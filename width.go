@@ -0,0 +1,123 @@
+package kanaconv
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	halfwidthFirst = '｡'
+	halfwidthLast  = 'ﾟ'
+	// halfwidthDakuten and halfwidthHandakuten are the sound marks which follow a half-width katakana (ｶﾞ, ﾊﾟ)
+	halfwidthDakuten    = 'ﾞ'
+	halfwidthHandakuten = 'ﾟ'
+)
+
+//	fullwidthKana are the full-width twins of the half-width characters from ｡ to ﾟ
+var fullwidthKana = []rune("。「」、・ヲァィゥェォャュョッーアイウエオカキクケコサシスセソタチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン゛゜")
+
+//	isHalfwidth checks whether the rune is a half-width katakana or a half-width Japanese punctuation mark.
+func isHalfwidth(r rune) bool {
+	return r >= halfwidthFirst && r <= halfwidthLast
+}
+
+//	toFullwidth converts a half-width character to its full-width twin.
+func toFullwidth(r rune) rune {
+	if isHalfwidth(r) {
+		return fullwidthKana[r-halfwidthFirst]
+	}
+
+	return r
+}
+
+//	halfwidthKana are the half-width forms of full-width katakana and punctuation marks, voiced kana are split (ガ -> ｶﾞ)
+var halfwidthKana = func() map[rune]string {
+	m := make(map[rune]string, len(fullwidthKana)*2)
+
+	for i, full := range fullwidthKana {
+		half := halfwidthFirst + rune(i)
+		m[full] = string(half)
+
+		if voiced, ok := withDakuten(full); ok {
+			m[voiced] = string([]rune{half, halfwidthDakuten})
+		}
+		if semiVoiced, ok := withHandakuten(full); ok {
+			m[semiVoiced] = string([]rune{half, halfwidthHandakuten})
+		}
+	}
+
+	return m
+}()
+
+//	NoHalfwidthError is returned when kana have no half-width form (e.g. ヰ, ヱ, ヮ).
+type NoHalfwidthError struct {
+	Runes []rune
+}
+
+func (e *NoHalfwidthError) Error() string {
+	return fmt.Sprintf("kana without a half-width form: %s", string(e.Runes))
+}
+
+//	ToHalfwidthKatakana converts full-width katakana and Japanese punctuation marks to their half-width forms,
+//	voiced kana are split into the kana and a sound mark (ガ -> ｶﾞ, パ -> ﾊﾟ).
+//	Hiragana is converted only with WithHalfwidthHiragana, other characters are kept as they are.
+//	Kana which have no half-width form (ヰ, ヱ, ヮ, ヵ, ヶ, ...) are kept as they are and reported in *NoHalfwidthError.
+func ToHalfwidthKatakana(str string, opts ...Option) (result string, err error) {
+	o := newOptions(opts)
+
+	var sb strings.Builder
+	sb.Grow(len(str))
+
+	var missing []rune
+	for _, r := range str {
+		kana := r
+		if o.isHalfwidthHiragana && IsHiragana(r) {
+			kana = toKatakana(r)
+		}
+
+		if half, ok := halfwidthKana[kana]; ok {
+			sb.WriteString(half)
+		} else {
+			if IsKatakana(kana) && !isHalfwidth(kana) {
+				missing = append(missing, r)
+			}
+
+			sb.WriteRune(r)
+		}
+	}
+
+	if len(missing) != 0 {
+		return sb.String(), &NoHalfwidthError{Runes: missing}
+	}
+
+	return sb.String(), nil
+}
+
+//	ToFullwidthKatakana converts half-width katakana and punctuation marks to their full-width forms,
+//	half-width sound marks are combined with the preceding kana (ｶﾞ -> ガ, ﾊﾟ -> パ). Other characters are kept as they are,
+//	unlike KanaToRomaji it does not compose full-width sound marks or expand ligatures (see NormalizeKana).
+func ToFullwidthKatakana(str string) string {
+	var sb strings.Builder
+	sb.Grow(len(str))
+
+	runes := []rune(str)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if !isHalfwidth(r) {
+			sb.WriteRune(r)
+			continue
+		}
+
+		r = toFullwidth(r)
+		if i+1 < len(runes) && (runes[i+1] == halfwidthDakuten || runes[i+1] == halfwidthHandakuten) {
+			if voiced, ok := withSoundMark(r, runes[i+1]); ok {
+				r = voiced
+				i++
+			}
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
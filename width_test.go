@@ -0,0 +1,137 @@
+package kanaconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHalfwidthToRomaji(t *testing.T) {
+	input := []inp{
+		{input: "ｶﾀｶﾅ", want: "katakana"},
+		{input: "ｱｲｳｴｵﾝ", want: "aiueon"},
+		{input: "ｶﾞｷﾞｸﾞｹﾞｺﾞ", want: "gagigugego"},
+		{input: "ﾊﾟﾋﾟﾌﾟﾍﾟﾎﾟ", want: "papipupepo"},
+		{input: "ﾊﾞﾋﾞﾌﾞﾍﾞﾎﾞ", want: "babibubebo"},
+		{input: "ｳﾞ", want: "vu"},
+		{input: "ｦ", want: "wo"},
+		{input: "ﾗｰﾒﾝ", want: "raamen"},
+		{input: "ｷｯﾌﾟ", want: "kippu"},
+		{input: "ﾏｯﾁｬ", want: "matcha"},
+		{input: "ｼﾞ･ｴﾝﾄﾞ", want: "jiendo"},
+		{input: "ｷｬｸ", want: "kyaku"},
+		{input: "ｶﾀカナ", want: "katakana"},
+	}
+
+	for _, v := range input {
+		got, err := KanaToRomaji(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestHalfwidthInvalid(t *testing.T) {
+	for _, v := range []string{"ﾞｶ", "ｱﾞ", "ｰ", "ｯｱ"} {
+		got, err := KanaToRomaji(v)
+		assert.Empty(t, got)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestHalfwidthToHiragana(t *testing.T) {
+	input := []inp{
+		{input: "ｶﾞｯｺｳ", want: "がっこう"},
+		{input: "ﾗｰﾒﾝ", want: "らーめん"},
+		{input: "ﾜﾞ", want: "わ゙"},
+	}
+
+	for _, v := range input {
+		got := KatakanaToHiragana(v.input)
+		assert.Equal(t, v.want, got)
+	}
+}
+
+func TestHalfwidthFullwidthTable(t *testing.T) {
+	input := []inp{
+		{input: "｡｢｣､･", want: "。「」、・"},
+		{input: "ｦｧｨｩｪｫｬｭｮｯｰ", want: "ヲァィゥェォャュョッー"},
+		{input: "ﾏﾐﾑﾒﾓﾔﾕﾖﾗﾘﾙﾚﾛﾜﾝﾞﾟ", want: "マミムメモヤユヨラリルレロワン゛゜"},
+	}
+
+	for _, v := range input {
+		var got []rune
+		for _, r := range v.input {
+			got = append(got, toFullwidth(r))
+		}
+
+		assert.Equal(t, v.want, string(got))
+	}
+}
+
+func TestHalfwidthCategory(t *testing.T) {
+	for _, r := range "ｦｧｯｰｱﾝ" {
+		assert.True(t, IsKatakana(r))
+	}
+
+	for _, r := range "｡･ﾞﾟ" {
+		assert.False(t, IsKatakana(r))
+	}
+}
+
+func TestToHalfwidthKatakana(t *testing.T) {
+	input := []inp{
+		{input: "カタカナ", want: "ｶﾀｶﾅ"},
+		{input: "ガギグゲゴ", want: "ｶﾞｷﾞｸﾞｹﾞｺﾞ"},
+		{input: "パピプペポ", want: "ﾊﾟﾋﾟﾌﾟﾍﾟﾎﾟ"},
+		{input: "ヴヷヺ", want: "ｳﾞﾜﾞｦﾞ"},
+		{input: "ァィゥェォャュョッ", want: "ｧｨｩｪｫｬｭｮｯ"},
+		{input: "ラーメン・ギョーザ。", want: "ﾗｰﾒﾝ･ｷﾞｮｰｻﾞ｡"},
+		{input: "「カナ」、日本", want: "｢ｶﾅ｣､日本"},
+		{input: "ひらがなとｶﾀｶﾅ", want: "ひらがなとｶﾀｶﾅ"},
+	}
+
+	for _, v := range input {
+		got, err := ToHalfwidthKatakana(v.input)
+		assert.Equal(t, v.want, got)
+		assert.Nil(t, err)
+	}
+}
+
+func TestToHalfwidthKatakanaHiragana(t *testing.T) {
+	got, err := ToHalfwidthKatakana("がっこうのパン", WithHalfwidthHiragana())
+	assert.Equal(t, "ｶﾞｯｺｳﾉﾊﾟﾝ", got)
+	assert.Nil(t, err)
+}
+
+func TestToHalfwidthKatakanaMissing(t *testing.T) {
+	got, err := ToHalfwidthKatakana("ヰヱヮカ")
+	assert.Equal(t, "ヰヱヮｶ", got)
+
+	var halfwidthErr *NoHalfwidthError
+	assert.ErrorAs(t, err, &halfwidthErr)
+	assert.Equal(t, []rune{'ヰ', 'ヱ', 'ヮ'}, halfwidthErr.Runes)
+	assert.EqualError(t, err, "kana without a half-width form: ヰヱヮ")
+
+	got, err = ToHalfwidthKatakana("ゐ", WithHalfwidthHiragana())
+	assert.Equal(t, "ゐ", got)
+	assert.ErrorAs(t, err, &halfwidthErr)
+}
+
+func TestToFullwidthKatakana(t *testing.T) {
+	input := []inp{
+		{input: "ｶﾀｶﾅ", want: "カタカナ"},
+		{input: "ｶﾞｷﾞｸﾞｹﾞｺﾞ", want: "ガギグゲゴ"},
+		{input: "ﾊﾟﾋﾟﾌﾟﾍﾟﾎﾟ", want: "パピプペポ"},
+		{input: "ｳﾞﾜﾞｦﾞ", want: "ヴヷヺ"},
+		{input: "ﾗｰﾒﾝ･ｷﾞｮｰｻﾞ｡", want: "ラーメン・ギョーザ。"},
+		{input: "ｱﾞ", want: "ア゛"},
+		{input: "日本ｶﾅabc", want: "日本カナabc"},
+		{input: "㌔ヿか゛ゝ", want: "㌔ヿか゛ゝ"},
+		{input: "ｶ\u3099", want: "カ\u3099"},
+	}
+
+	for _, v := range input {
+		got := ToFullwidthKatakana(v.input)
+		assert.Equal(t, v.want, got)
+	}
+}